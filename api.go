@@ -3,10 +3,15 @@ package main
 import (
 	"bufio"         // Package for reading files line by line
 	"encoding/json" // Package for JSON encoding and decoding
+	"errors"        // Package for creating error values
 	"fmt"           // Package for formatted I/O operations
 	"io"            // Package for I/O primitives
+	"net"           // Package for the quick connectivity probe
 	"net/http"      // Package for HTTP client and server implementations
+	"net/url"       // Package for finding the API's host
 	"os"            // Package for file operations
+	"sort"          // Package for sorting slices
+	"strconv"       // Package for converting strings to numbers
 	"strings"       // Package for string manipulation functions
 	"time"          // Package for time-related operations
 )
@@ -19,27 +24,30 @@ const (
 
 // APIPlayer represents the raw player data structure returned by the NBA API
 type APIPlayer struct {
-	ID           int    `json:"id"`            // Unique player identifier
-	FirstName    string `json:"first_name"`    // Player's first name
-	LastName     string `json:"last_name"`     // Player's last name
-	Position     string `json:"position"`      // Playing position
-	Height       string `json:"height"`        // Player height (e.g., "6-2")
-	Weight       string `json:"weight"`        // Player weight in pounds
-	JerseyNumber string `json:"jersey_number"` // Jersey number
-	College      string `json:"college"`       // College attended
-	Country      string `json:"country"`       // Country of origin
-	DraftYear    *int   `json:"draft_year"`    // Draft year (pointer to handle null)
-	DraftRound   *int   `json:"draft_round"`   // Draft round (pointer to handle null)
-	DraftNumber  *int   `json:"draft_number"`  // Draft number (pointer to handle null)
-	Team         struct {
-		ID           int    `json:"id"`           // Team identifier
-		Conference   string `json:"conference"`   // Team conference
-		Division     string `json:"division"`     // Team division
-		City         string `json:"city"`         // Team city
-		Name         string `json:"name"`         // Team name
-		FullName     string `json:"full_name"`    // Full team name
-		Abbreviation string `json:"abbreviation"` // Team abbreviation
-	} `json:"team"` // Current team information
+	ID           int      `json:"id"`            // Unique player identifier
+	FirstName    string   `json:"first_name"`    // Player's first name
+	LastName     string   `json:"last_name"`     // Player's last name
+	Position     string   `json:"position"`      // Playing position
+	Height       string   `json:"height"`        // Player height (e.g., "6-2")
+	Weight       string   `json:"weight"`        // Player weight in pounds
+	JerseyNumber string   `json:"jersey_number"` // Jersey number
+	College      string   `json:"college"`       // College attended
+	Country      string   `json:"country"`       // Country of origin
+	DraftYear    *int     `json:"draft_year"`    // Draft year (pointer to handle null)
+	DraftRound   *int     `json:"draft_round"`   // Draft round (pointer to handle null)
+	DraftNumber  *int     `json:"draft_number"`  // Draft number (pointer to handle null)
+	Team         *APITeam `json:"team"`          // Current team (nil when the API sends null for a free agent)
+}
+
+// APITeam represents a team as embedded in an API player record
+type APITeam struct {
+	ID           int    `json:"id"`           // Team identifier
+	Conference   string `json:"conference"`   // Team conference
+	Division     string `json:"division"`     // Team division
+	City         string `json:"city"`         // Team city
+	Name         string `json:"name"`         // Team name
+	FullName     string `json:"full_name"`    // Full team name
+	Abbreviation string `json:"abbreviation"` // Team abbreviation
 }
 
 // APIResponse represents the structure of API responses
@@ -51,22 +59,21 @@ type APIResponse struct {
 	} `json:"meta"` // Pagination metadata
 }
 
-// Global cache variables to store API responses and reduce repeated requests
-var playerCache = make(map[int]*Player) // Cache individual player data by ID
-var allPlayersCache []Player            // Cache all players list
-var cacheExpiry time.Time               // Timestamp when cache expires
-
 // loadEnvFile loads environment variables from .env file
-func loadEnvFile() error {
+// Returns a warning for every line that was skipped and when the API key isn't set
+func loadEnvFile() ([]string, error) {
 	file, err := os.Open(".env")
 	if err != nil {
 		// .env file doesn't exist, which is okay
-		return nil
+		return nil, nil
 	}
 	defer file.Close()
 
+	var warnings []string
+	lineNumber := 0
 	scanner := bufio.NewScanner(file)
 	for scanner.Scan() {
+		lineNumber++
 		line := strings.TrimSpace(scanner.Text())
 
 		// Skip empty lines and comments
@@ -77,29 +84,52 @@ func loadEnvFile() error {
 		// Split on first = sign
 		parts := strings.SplitN(line, "=", 2)
 		if len(parts) != 2 {
+			warnings = append(warnings, fmt.Sprintf("line %d skipped: expected KEY=value", lineNumber))
 			continue
 		}
 
 		key := strings.TrimSpace(parts[0])
 		value := strings.TrimSpace(parts[1])
+		if key == "" {
+			warnings = append(warnings, fmt.Sprintf("line %d skipped: missing key before '='", lineNumber))
+			continue
+		}
 
 		// Remove quotes if present
-		if (strings.HasPrefix(value, "\"") && strings.HasSuffix(value, "\"")) ||
-			(strings.HasPrefix(value, "'") && strings.HasSuffix(value, "'")) {
+		if len(value) >= 2 && ((strings.HasPrefix(value, "\"") && strings.HasSuffix(value, "\"")) ||
+			(strings.HasPrefix(value, "'") && strings.HasSuffix(value, "'"))) {
 			value = value[1 : len(value)-1]
+		} else if strings.HasPrefix(value, "\"") || strings.HasPrefix(value, "'") {
+			warnings = append(warnings, fmt.Sprintf("line %d: value for %s has an unterminated quote", lineNumber, key))
 		}
 
 		// Set environment variable
 		os.Setenv(key, value)
 	}
+	if err := scanner.Err(); err != nil {
+		return warnings, err
+	}
 
-	return scanner.Err()
+	// The key is the only thing the game reads from .env, so point out when it's missing
+	if os.Getenv("BALLDONTLIE_API_KEY") == "" {
+		warnings = append(warnings, "BALLDONTLIE_API_KEY is not set")
+	}
+	return warnings, nil
 }
 
 // getAPIKey retrieves the API key from .env file or environment variable
-func getAPIKey() string {
+// Problems with the .env file are printed in verbose mode
+func getAPIKey(verbose bool) string {
 	// First, try to load from .env file
-	loadEnvFile()
+	warnings, err := loadEnvFile()
+	if verbose {
+		for _, warning := range warnings {
+			fmt.Printf("Warning: .env: %s\n", warning)
+		}
+		if err != nil {
+			fmt.Printf("Warning: could not read .env: %v\n", err)
+		}
+	}
 
 	// Get API key from environment variable
 	apiKey := os.Getenv("BALLDONTLIE_API_KEY")
@@ -112,8 +142,73 @@ func getAPIKey() string {
 	return apiKey
 }
 
+// errNoAPIKey is returned when the API can't be used because no key is configured
+var errNoAPIKey = errors.New("no API key provided")
+
+// apiKeyURL is where a free Ball Don't Lie API key can be created
+const apiKeyURL = "https://app.balldontlie.io"
+
+// saveAPIKey stores the key in .env, replacing an existing BALLDONTLIE_API_KEY line or adding one,
+// and sets it for this run
+func saveAPIKey(key string) error {
+	var lines []string
+	if data, err := os.ReadFile(".env"); err == nil {
+		lines = strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+	} else if !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read .env: %v", err)
+	}
+
+	replaced := false
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "BALLDONTLIE_API_KEY=") {
+			lines[i] = "BALLDONTLIE_API_KEY=" + key
+			replaced = true
+		}
+	}
+	if !replaced {
+		lines = append(lines, "BALLDONTLIE_API_KEY="+key)
+	}
+	if err := os.WriteFile(".env", []byte(strings.Join(lines, "\n")+"\n"), 0600); err != nil {
+		return fmt.Errorf("failed to write .env: %v", err)
+	}
+	return os.Setenv("BALLDONTLIE_API_KEY", key)
+}
+
+// offerAPIKey handles a missing API key before the players are loaded: an interactive player is
+// asked once to paste a key (saved to .env) or press Enter for the legends-only list, while
+// scripted runs fall back silently. With -strict a missing key is an error instead
+func offerAPIKey(config GameConfig, input <-chan string, interactive bool) error {
+	if len(config.PlayersFiles) > 0 || len(config.PlayersCSVFiles) > 0 || getAPIKey(config.Verbose) != "" {
+		return nil // The API isn't needed, or a key is ready
+	}
+	if !interactive {
+		if config.Strict {
+			return fmt.Errorf("no Ball Don't Lie API key found - set BALLDONTLIE_API_KEY in .env (get one at %s), or drop -strict to play with the legends-only list", apiKeyURL)
+		}
+		return nil
+	}
+
+	fmt.Printf("🔑 No Ball Don't Lie API key found. Get a free key at %s to play with every current player.\n", apiKeyURL)
+	fmt.Print("Paste your key to save it to .env, or press Enter to play with the legends-only list: ")
+	answer, ok := <-input
+	key := strings.TrimSpace(answer)
+	if !ok || key == "" {
+		if config.Strict {
+			return fmt.Errorf("no API key entered (-strict doesn't allow the legends-only list)")
+		}
+		fmt.Println("Playing with the legends-only list.")
+		return nil
+	}
+	if err := saveAPIKey(key); err != nil {
+		return err
+	}
+	fmt.Println("✅ API key saved to .env.")
+	return nil
+}
+
 // makeAPIRequest performs HTTP GET request to NBA API with proper headers and authentication
-func makeAPIRequest(url string) ([]byte, error) {
+// Debug output about authentication is only printed in verbose mode
+func makeAPIRequest(url string, verbose bool) ([]byte, error) {
 	// Create HTTP client with 30-second timeout
 	client := &http.Client{
 		Timeout: 30 * time.Second,
@@ -132,15 +227,13 @@ func makeAPIRequest(url string) ([]byte, error) {
 	req.Header.Set("Connection", "keep-alive")
 
 	// Check for API key and set Authorization header
-	apiKey := getAPIKey()
+	apiKey := getAPIKey(false) // .env problems were already reported before the first request
 	if apiKey != "" {
 		// Use the correct Authorization header format for Ball Don't Lie API
 		req.Header.Set("Authorization", apiKey)
-		fmt.Printf("DEBUG: Using API key for authentication (key: %s...)\n", apiKey[:8])
-	} else {
-		fmt.Printf("DEBUG: No API key found - API may return limited data or require authentication\n")
-		fmt.Printf("DEBUG: To get an API key, visit: https://app.balldontlie.io\n")
-		fmt.Printf("DEBUG: Then add it to your .env file: BALLDONTLIE_API_KEY=your_actual_key\n")
+		if verbose {
+			fmt.Printf("DEBUG: Using API key for authentication (key: %s...)\n", apiKey[:min(8, len(apiKey))])
+		}
 	}
 
 	// Execute the HTTP request
@@ -169,52 +262,117 @@ func makeAPIRequest(url string) ([]byte, error) {
 	return body, nil
 }
 
+// errOffline is returned when the connectivity probe can't reach the API
+var errOffline = errors.New("the NBA API can't be reached")
+
+// dialFunc opens a network connection within a timeout, like net.DialTimeout
+type dialFunc func(network, address string, timeout time.Duration) (net.Conn, error)
+
+// probeAPI checks that the API host answers a TCP connection within the timeout, so an offline
+// start falls back at once instead of waiting out DNS and HTTP timeouts; 0 skips the probe
+// The connection is opened with dial, net.DialTimeout outside of tests
+func probeAPI(timeout time.Duration, dial dialFunc) error {
+	if timeout <= 0 {
+		return nil
+	}
+	base, err := url.Parse(NBA_API_BASE)
+	if err != nil {
+		return err
+	}
+	port := base.Port()
+	if port == "" {
+		port = "443" // The API is only served over HTTPS
+	}
+	conn, err := dial("tcp", net.JoinHostPort(base.Hostname(), port), timeout)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errOffline, err)
+	}
+	return conn.Close()
+}
+
 // fetchAllPlayers retrieves comprehensive player data from NBA API
-func fetchAllPlayers() ([]Player, error) {
+func fetchAllPlayers(config GameConfig) ([]Player, error) {
 	// Check if cached data is still valid (within 1 hour)
-	if time.Now().Before(cacheExpiry) && len(allPlayersCache) > 0 {
-		return allPlayersCache, nil // Return cached data if still valid
+	if cached, found := store.CachedAPIPlayers(); found {
+		return cached, nil // Return cached data if still valid
+	}
+
+	// Without a key there's nothing to fetch; offerAPIKey has already explained the fallback
+	apiKey := getAPIKey(config.Verbose)
+	if apiKey == "" {
+		return nil, errNoAPIKey
+	}
+
+	// Skip straight to the fallback when there's no network, rather than waiting on timeouts
+	if err := probeAPI(config.ProbeTimeout, net.DialTimeout); err != nil {
+		fmt.Println("📡 Offline - the NBA API can't be reached. Using the built-in player list.")
+		if config.Verbose {
+			fmt.Printf("DEBUG: %v\n", err)
+		}
+		return nil, err
 	}
 
 	// Inform user that API fetch is starting
 	fmt.Println("Fetching NBA players from Ball Don't Lie API...")
+	fmt.Println("Note: Using API key from .env file for full player database access.")
 
-	// Check if API key is available
-	apiKey := getAPIKey()
-	if apiKey == "" {
-		fmt.Println("Note: No API key found in .env file. You can:")
-		fmt.Println("1. Get a free API key from https://app.balldontlie.io")
-		fmt.Println("2. Add it to your .env file: BALLDONTLIE_API_KEY=your_actual_key")
-		fmt.Println("3. The game will use a fallback list of legendary players")
-		return nil, fmt.Errorf("no API key provided")
-	} else {
-		fmt.Println("Note: Using API key from .env file for full player database access.")
+	allPlayers, err := fetchPages(NBA_API_BASE, 1*time.Second, config) // Pause between requests for rate limiting
+	if err != nil {
+		return nil, err
 	}
 
+	// Cache the results for 1 hour to improve performance
+	store.CacheAPIPlayers(allPlayers, 1*time.Hour)
+	fmt.Printf("Successfully loaded %d NBA players from API!\n", len(allPlayers))
+	return allPlayers, nil
+}
+
+// fetchPages pages through the players API at base until the last page or -max-pages,
+// pausing between requests, and returns the players sorted by API ID
+func fetchPages(base string, pause time.Duration, config GameConfig) ([]Player, error) {
 	// Initialize slice to store all players
 	var allPlayers []Player
 
+	// Count players whose draft year had to be discarded
+	invalidDraftYears := 0
+
+	// Report progress as a spinner on a terminal, or as log lines otherwise
+	progress := newFetchProgress(config.Verbose)
+	defer progress.stop()
+
+	// Current rosters make a friendlier default pool; -historical includes every player ever
+	endpoint := "/players/active"
+	if config.Historical {
+		endpoint = "/players"
+	}
+
 	// Start with cursor 0 and continue until we reach the end or hit our limit
 	cursor := 0
-	maxPages := 10 // Fetch more pages since we have authentication
 	pageCount := 0
 
-	for pageCount < maxPages {
-		// Construct API URL for current cursor with 100 players per page (max allowed)
+	for pageCount < config.MaxPages {
+		// Construct API URL for current cursor with the configured page size
 		var url string
 		if cursor == 0 {
-			url = fmt.Sprintf("%s/players?per_page=100", NBA_API_BASE)
+			url = fmt.Sprintf("%s%s?per_page=%d", base, endpoint, config.PerPage)
 		} else {
-			url = fmt.Sprintf("%s/players?cursor=%d&per_page=100", NBA_API_BASE, cursor)
+			url = fmt.Sprintf("%s%s?cursor=%d&per_page=%d", base, endpoint, cursor, config.PerPage)
 		}
 
 		// Make API request for current page
-		data, err := makeAPIRequest(url)
+		data, err := makeAPIRequest(url, config.Verbose)
 		if err != nil {
 			// If we have some players already, return them instead of failing completely
 			if len(allPlayers) > 0 {
 				break
 			}
+
+			// The active-players endpoint needs a paid API tier, so fall back to all players
+			if endpoint == "/players/active" {
+				progress.logf("Active players endpoint unavailable (%v) - loading all players instead\n", err)
+				endpoint = "/players"
+				continue
+			}
 			return nil, fmt.Errorf("failed to fetch players: %v", err)
 		}
 
@@ -233,16 +391,23 @@ func fetchAllPlayers() ([]Player, error) {
 				continue
 			}
 
+			// Note draft years the API reports outside the valid range
+			if apiPlayer.DraftYear != nil && !isValidDraftYear(*apiPlayer.DraftYear) {
+				invalidDraftYears++
+			}
+
 			// Create full player name
 			playerName := fmt.Sprintf("%s %s", apiPlayer.FirstName, apiPlayer.LastName)
 			currentTeam := getTeamName(apiPlayer)
 
 			// Create Player struct with available information from API
 			player := Player{
+				ID:           apiPlayer.ID,                            // Keep API ID for save files
 				Name:         playerName,                              // Combine first and last name
 				Team:         currentTeam,                             // Extract team name
 				Position:     getPosition(apiPlayer.Position),         // Extract and validate position
 				Height:       formatHeightFromAPI(apiPlayer.Height),   // Format height from API
+				Weight:       getWeight(apiPlayer.Weight),             // Parse weight in pounds
 				College:      getCollege(apiPlayer.College),           // Get college info
 				DraftYear:    getDraftYear(apiPlayer.DraftYear),       // Get draft year
 				DraftRound:   getDraftRound(apiPlayer.DraftRound),     // Get draft round
@@ -257,18 +422,17 @@ func fetchAllPlayers() ([]Player, error) {
 		}
 
 		// Show progress to user
-		fmt.Printf("Loaded %d players so far... (processed %d from cursor %d)\n",
-			len(allPlayers), playersProcessed, cursor)
+		progress.update(len(allPlayers), playersProcessed, cursor)
 
 		// Check if we've reached the last page
-		if response.Meta.NextCursor == nil || len(response.Data) < 100 {
-			fmt.Printf("Reached end of data at cursor %d (NextCursor: %v, DataCount: %d)\n",
+		if response.Meta.NextCursor == nil || len(response.Data) < config.PerPage {
+			progress.logf("Reached end of data at cursor %d (NextCursor: %v, DataCount: %d)\n",
 				cursor, response.Meta.NextCursor, len(response.Data))
 			break
 		}
 
 		// Add delay between requests to be respectful to the API
-		time.Sleep(1 * time.Second) // Increased delay for rate limiting
+		time.Sleep(pause)
 
 		// Move to next cursor
 		cursor = *response.Meta.NextCursor
@@ -280,24 +444,36 @@ func fetchAllPlayers() ([]Player, error) {
 		return nil, fmt.Errorf("no players retrieved from API - authentication may be required")
 	}
 
-	// Cache the results for 1 hour to improve performance
-	allPlayersCache = allPlayers
-	cacheExpiry = time.Now().Add(1 * time.Hour)
-
-	// Inform user of successful completion
-	fmt.Printf("Successfully loaded %d NBA players from API!\n", len(allPlayers))
+	// Sort by API ID so the same seed always maps to the same player, whatever order pages arrived in
+	sort.SliceStable(allPlayers, func(i, j int) bool {
+		if allPlayers[i].ID != allPlayers[j].ID {
+			return allPlayers[i].ID < allPlayers[j].ID
+		}
+		return allPlayers[i].Name < allPlayers[j].Name
+	})
+
+	// Warn about discarded draft years once the progress line is gone
+	progress.stop()
+	if invalidDraftYears > 0 {
+		fmt.Printf("Warning: %d players had a draft year outside %d-%d and were marked as unknown\n",
+			invalidDraftYears, firstDraftYear, time.Now().Year())
+	}
 	return allPlayers, nil
 }
 
 // getTeamName safely extracts team name from API player data
 func getTeamName(apiPlayer APIPlayer) string {
+	// A null team means the player is a free agent
+	if apiPlayer.Team == nil {
+		return freeAgentTeam
+	}
 	// Check if team information is available
 	if apiPlayer.Team.FullName != "" {
 		return apiPlayer.Team.FullName // Return full team name if available
 	} else if apiPlayer.Team.Name != "" {
 		return apiPlayer.Team.Name // Return team name if available
 	}
-	return "Free Agent" // Default value if no team information
+	return freeAgentTeam // Default value if no team information
 }
 
 // getPosition validates and returns a clean position string
@@ -344,6 +520,15 @@ func formatHeightFromAPI(height string) string {
 	return height // Return as-is if format is unexpected
 }
 
+// getWeight parses the API weight in pounds, returning 0 if it is missing or malformed
+func getWeight(weight string) int {
+	pounds, err := strconv.Atoi(strings.TrimSpace(weight))
+	if err != nil || pounds < 0 {
+		return 0
+	}
+	return pounds
+}
+
 // getCollege returns college information or default
 func getCollege(college string) string {
 	if college == "" {
@@ -352,14 +537,20 @@ func getCollege(college string) string {
 	return college
 }
 
-// getDraftYear returns draft year or estimated year
+// getDraftYear returns the draft year, or unknownDraftYear when it is missing or outside the valid range
 func getDraftYear(draftYear *int) int {
-	if draftYear != nil {
-		return *draftYear
+	if draftYear == nil {
+		return unknownDraftYear // Missing years are not guessed at
 	}
+	if !isValidDraftYear(*draftYear) {
+		return unknownDraftYear // Out-of-range years are treated as missing
+	}
+	return *draftYear
+}
 
-	// Return a reasonable default for unknown draft years
-	return 2020 // Default to recent year
+// isValidDraftYear reports whether a year falls between the first NBA draft and the current year
+func isValidDraftYear(year int) bool {
+	return year >= firstDraftYear && year <= time.Now().Year()
 }
 
 // getDraftRound returns draft round or 0 for undrafted
@@ -403,6 +594,7 @@ func getFallbackPlayers() []Player {
 			Team:         "Los Angeles Lakers", // Current team
 			Position:     "SF",                 // Small Forward
 			Height:       "6'9\"",              // Height in feet/inches
+			Weight:       250,                  // Weight in pounds
 			College:      "None",               // Straight from high school
 			DraftYear:    2003,                 // Draft year
 			DraftRound:   1,                    // First round
@@ -415,6 +607,7 @@ func getFallbackPlayers() []Player {
 			Team:         "Retired",        // No longer active
 			Position:     "SG",             // Shooting Guard
 			Height:       "6'6\"",          // Height in feet/inches
+			Weight:       216,              // Weight in pounds
 			College:      "North Carolina", // College attended
 			DraftYear:    1984,             // Draft year
 			DraftRound:   1,                // First round
@@ -427,6 +620,7 @@ func getFallbackPlayers() []Player {
 			Team:         "Retired",     // No longer active
 			Position:     "SG",          // Shooting Guard
 			Height:       "6'6\"",       // Height in feet/inches
+			Weight:       212,           // Weight in pounds
 			College:      "None",        // Straight from high school
 			DraftYear:    1996,          // Draft year
 			DraftRound:   1,             // First round
@@ -439,6 +633,7 @@ func getFallbackPlayers() []Player {
 			Team:         "Golden State Warriors", // Current team
 			Position:     "PG",                    // Point Guard
 			Height:       "6'2\"",                 // Height in feet/inches
+			Weight:       185,                     // Weight in pounds
 			College:      "Davidson",              // College attended
 			DraftYear:    2009,                    // Draft year
 			DraftRound:   1,                       // First round
@@ -451,6 +646,7 @@ func getFallbackPlayers() []Player {
 			Team:         "Phoenix Suns", // Current team
 			Position:     "SF",           // Small Forward
 			Height:       "6'10\"",       // Height in feet/inches
+			Weight:       240,            // Weight in pounds
 			College:      "Texas",        // College attended
 			DraftYear:    2007,           // Draft year
 			DraftRound:   1,              // First round
@@ -463,6 +659,7 @@ func getFallbackPlayers() []Player {
 			Team:         "Milwaukee Bucks",       // Current team
 			Position:     "PF",                    // Power Forward
 			Height:       "6'11\"",                // Height in feet/inches
+			Weight:       243,                     // Weight in pounds
 			College:      "None",                  // International player
 			DraftYear:    2013,                    // Draft year
 			DraftRound:   1,                       // First round
@@ -475,6 +672,7 @@ func getFallbackPlayers() []Player {
 			Team:         "Dallas Mavericks", // Current team
 			Position:     "PG",               // Point Guard
 			Height:       "6'7\"",            // Height in feet/inches
+			Weight:       230,                // Weight in pounds
 			College:      "None",             // International player
 			DraftYear:    2018,               // Draft year
 			DraftRound:   1,                  // First round
@@ -487,6 +685,7 @@ func getFallbackPlayers() []Player {
 			Team:         "Philadelphia 76ers", // Current team
 			Position:     "C",                  // Center
 			Height:       "7'0\"",              // Height in feet/inches
+			Weight:       280,                  // Weight in pounds
 			College:      "Kansas",             // College attended
 			DraftYear:    2014,                 // Draft year
 			DraftRound:   1,                    // First round
@@ -499,6 +698,7 @@ func getFallbackPlayers() []Player {
 			Team:         "Denver Nuggets", // Current team
 			Position:     "C",              // Center
 			Height:       "6'11\"",         // Height in feet/inches
+			Weight:       284,              // Weight in pounds
 			College:      "None",           // International player
 			DraftYear:    2014,             // Draft year
 			DraftRound:   2,                // Second round
@@ -511,6 +711,7 @@ func getFallbackPlayers() []Player {
 			Team:         "Boston Celtics", // Current team
 			Position:     "SF",             // Small Forward
 			Height:       "6'8\"",          // Height in feet/inches
+			Weight:       210,              // Weight in pounds
 			College:      "Duke",           // College attended
 			DraftYear:    2017,             // Draft year
 			DraftRound:   1,                // First round
@@ -0,0 +1,449 @@
+package main
+
+import (
+	"encoding/json"     // Package for encoding the fake API pages
+	"errors"            // Package for matching wrapped errors
+	"fmt"               // Package for formatted I/O operations
+	"net"               // Package for the fake connections
+	"net/http"          // Package for HTTP handlers
+	"net/http/httptest" // Package for the fake API server
+	"os"                // Package for file operations
+	"path/filepath"     // Package for building file paths
+	"reflect"           // Package for comparing orderings
+	"strconv"           // Package for parsing the page cursor
+	"sync"              // Package for guarding the recorded paths
+	"sync/atomic"       // Package for counting requests across handler goroutines
+	"testing"           // Package for Go tests
+	"time"              // Package for time-related operations
+)
+
+// TestProbeAPIOffline checks that a failed dial is reported as errOffline, without any network
+func TestProbeAPIOffline(t *testing.T) {
+	refused := errors.New("connection refused")
+	dial := func(network, address string, timeout time.Duration) (net.Conn, error) {
+		return nil, refused
+	}
+	err := probeAPI(time.Second, dial)
+	if !errors.Is(err, errOffline) {
+		t.Errorf("probeAPI() = %v, want errOffline", err)
+	}
+}
+
+// trackedConn records whether its connection was closed
+type trackedConn struct {
+	net.Conn
+	closed bool
+}
+
+// Close closes the connection and records it
+func (c *trackedConn) Close() error {
+	c.closed = true
+	return c.Conn.Close()
+}
+
+// TestProbeAPIOnline checks that the probe dials the API host over HTTPS with the timeout,
+// and closes the connection it opened
+func TestProbeAPIOnline(t *testing.T) {
+	var dialed string
+	var waited time.Duration
+	var conn *trackedConn
+	dial := func(network, address string, timeout time.Duration) (net.Conn, error) {
+		dialed, waited = network+" "+address, timeout
+		client, server := net.Pipe()
+		server.Close()
+		conn = &trackedConn{Conn: client}
+		return conn, nil
+	}
+	if err := probeAPI(3*time.Second, dial); err != nil {
+		t.Fatalf("probeAPI() = %v", err)
+	}
+	if dialed != "tcp api.balldontlie.io:443" || waited != 3*time.Second {
+		t.Errorf("dialed %q with a %v timeout, want \"tcp api.balldontlie.io:443\" with 3s", dialed, waited)
+	}
+	if !conn.closed {
+		t.Error("the probe connection was left open")
+	}
+}
+
+// TestProbeAPISkipped checks that a zero timeout skips the probe without dialing
+func TestProbeAPISkipped(t *testing.T) {
+	dial := func(network, address string, timeout time.Duration) (net.Conn, error) {
+		t.Error("dialed with the probe turned off")
+		return nil, errors.New("unexpected dial")
+	}
+	if err := probeAPI(0, dial); err != nil {
+		t.Errorf("probeAPI(0) = %v", err)
+	}
+}
+
+// TestIsValidDraftYear checks the draft-year bounds: the first draft up to the current year
+func TestIsValidDraftYear(t *testing.T) {
+	thisYear := time.Now().Year()
+	tests := map[int]bool{
+		firstDraftYear - 1: false,
+		firstDraftYear:     true,
+		2003:               true,
+		thisYear:           true,
+		thisYear + 1:       false,
+		unknownDraftYear:   false,
+		-2003:              false,
+	}
+	for year, want := range tests {
+		if got := isValidDraftYear(year); got != want {
+			t.Errorf("isValidDraftYear(%d) = %v, want %v", year, got, want)
+		}
+	}
+}
+
+// fakeAPI serves endless pages of players, perPage per page with ascending IDs, and counts the
+// requests it answers
+func fakeAPI(t *testing.T, requests *atomic.Int32) *httptest.Server {
+	t.Helper()
+	t.Setenv("BALLDONTLIE_API_KEY", "") // Requests go to the fake server without a key
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		cursor, _ := strconv.Atoi(r.URL.Query().Get("cursor"))
+		perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+		var response APIResponse
+		for i := 1; i <= perPage; i++ {
+			id := cursor + i
+			response.Data = append(response.Data, APIPlayer{ID: id, FirstName: "Test", LastName: fmt.Sprintf("Player%d", id), Position: "G"})
+		}
+		next := cursor + perPage
+		response.Meta.NextCursor = &next
+		response.Meta.PerPage = perPage
+		json.NewEncoder(w).Encode(response)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// TestFetchPagesHonorsMaxPages checks that paging stops at -max-pages even when the API has more
+func TestFetchPagesHonorsMaxPages(t *testing.T) {
+	for _, maxPages := range []int{1, 3} {
+		var requests atomic.Int32
+		server := fakeAPI(t, &requests)
+		config := defaultConfig()
+		config.MaxPages = maxPages
+		config.PerPage = 5
+		players, err := fetchPages(server.URL, 0, config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := int(requests.Load()); got != maxPages {
+			t.Errorf("-max-pages=%d made %d requests", maxPages, got)
+		}
+		if len(players) != maxPages*config.PerPage {
+			t.Errorf("-max-pages=%d loaded %d players, want %d", maxPages, len(players), maxPages*config.PerPage)
+		}
+	}
+}
+
+// TestFetchPagesStableOrder checks that players come back sorted by API ID, with ties broken
+// by name, however the API ordered its pages
+func TestFetchPagesStableOrder(t *testing.T) {
+	t.Setenv("BALLDONTLIE_API_KEY", "")
+	pages := [][]APIPlayer{
+		{{ID: 9, FirstName: "Test", LastName: "Nine"}, {ID: 2, FirstName: "Test", LastName: "Two"}},
+		{{ID: 5, FirstName: "Test", LastName: "Zed"}, {ID: 5, FirstName: "Test", LastName: "Five"}},
+		{{ID: 1, FirstName: "Test", LastName: "One"}},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cursor, _ := strconv.Atoi(r.URL.Query().Get("cursor"))
+		var response APIResponse
+		response.Data = pages[cursor]
+		if next := cursor + 1; next < len(pages) {
+			response.Meta.NextCursor = &next
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	config := defaultConfig()
+	config.PerPage = 2
+	want := []string{"Test One", "Test Two", "Test Five", "Test Zed", "Test Nine"}
+	for run := 0; run < 2; run++ {
+		players, err := fetchPages(server.URL, 0, config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for _, player := range players {
+			names = append(names, player.Name)
+		}
+		if !reflect.DeepEqual(names, want) {
+			t.Errorf("run %d: order = %v, want %v", run+1, names, want)
+		}
+	}
+}
+
+// useEnvFile writes contents to .env in a fresh working directory for one test; every key the
+// tests read is registered with t.Setenv so loadEnvFile's changes are undone afterwards
+func useEnvFile(t *testing.T, contents string) {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, ".env"), []byte(contents), 0600); err != nil {
+		t.Fatal(err)
+	}
+	previous, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(previous) })
+	for _, key := range []string{"BALLDONTLIE_API_KEY", "OTHER", "QUOTED", "SINGLE"} {
+		t.Setenv(key, "")
+	}
+}
+
+// TestLoadEnvFileMalformed checks that malformed .env lines are skipped with a warning each,
+// while comments, blank lines, and quoted values are handled without one
+func TestLoadEnvFileMalformed(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+		key      string
+		want     []string
+	}{
+		{"plain key", "BALLDONTLIE_API_KEY=abc123\n", "abc123", nil},
+		{"comments and blank lines", "# my key\n\n  # indented comment\nBALLDONTLIE_API_KEY=abc123\n", "abc123", nil},
+		{"double quotes", "BALLDONTLIE_API_KEY=\"abc 123\"\n", "abc 123", nil},
+		{"single quotes", "BALLDONTLIE_API_KEY='abc123'\n", "abc123", nil},
+		{"spaces around =", "BALLDONTLIE_API_KEY = abc123 \n", "abc123", nil},
+		{"value with =", "BALLDONTLIE_API_KEY=abc=123\n", "abc=123", nil},
+		{"missing =", "BALLDONTLIE_API_KEY abc123\nOTHER=1\n", "", []string{
+			"line 1 skipped: expected KEY=value",
+			"BALLDONTLIE_API_KEY is not set",
+		}},
+		{"missing key", "=abc123\nBALLDONTLIE_API_KEY=abc123\n", "abc123", []string{"line 1 skipped: missing key before '='"}},
+		{"unterminated quote", "BALLDONTLIE_API_KEY=\"abc123\n", "\"abc123", []string{
+			"line 1: value for BALLDONTLIE_API_KEY has an unterminated quote",
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			useEnvFile(t, test.contents)
+			warnings, err := loadEnvFile()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(warnings, test.want) {
+				t.Errorf("warnings = %q, want %q", warnings, test.want)
+			}
+			if got := os.Getenv("BALLDONTLIE_API_KEY"); got != test.key {
+				t.Errorf("BALLDONTLIE_API_KEY = %q, want %q", got, test.key)
+			}
+		})
+	}
+}
+
+// TestGetAPIKeyPlaceholder checks that the placeholder from the example .env counts as no key
+func TestGetAPIKeyPlaceholder(t *testing.T) {
+	useEnvFile(t, "BALLDONTLIE_API_KEY=your_api_key_here\n")
+	if key := getAPIKey(false); key != "" {
+		t.Errorf("getAPIKey() = %q, want no key for the placeholder", key)
+	}
+}
+
+// endpointAPI serves one page of players per endpoint, named after it, and records the paths
+// requested; endpoints not in players answer 401 like a tier without access
+func endpointAPI(t *testing.T, players map[string]string, paths *[]string) *httptest.Server {
+	t.Helper()
+	t.Setenv("BALLDONTLIE_API_KEY", "")
+	var mu sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		*paths = append(*paths, r.URL.Path)
+		mu.Unlock()
+		name, found := players[r.URL.Path]
+		if !found {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		var response APIResponse
+		response.Data = []APIPlayer{{ID: 1, FirstName: "Test", LastName: name, Position: "G"}}
+		json.NewEncoder(w).Encode(response)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// TestFetchPagesEndpoints checks which endpoint each setup loads from: active players by
+// default, every player with -historical, and every player when the active endpoint is refused
+func TestFetchPagesEndpoints(t *testing.T) {
+	both := map[string]string{"/players/active": "Active", "/players": "Historical"}
+	tests := []struct {
+		name       string
+		players    map[string]string
+		historical bool
+		wantPaths  []string
+		wantPlayer string
+	}{
+		{"active", both, false, []string{"/players/active"}, "Test Active"},
+		{"historical", both, true, []string{"/players"}, "Test Historical"},
+		{"active refused", map[string]string{"/players": "Historical"}, false, []string{"/players/active", "/players"}, "Test Historical"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var paths []string
+			server := endpointAPI(t, test.players, &paths)
+			config := defaultConfig()
+			config.Historical = test.historical
+			players, err := fetchPages(server.URL, 0, config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(paths, test.wantPaths) {
+				t.Errorf("requested %v, want %v", paths, test.wantPaths)
+			}
+			if len(players) != 1 || players[0].Name != test.wantPlayer {
+				t.Errorf("loaded %v, want only %s", players, test.wantPlayer)
+			}
+		})
+	}
+
+	// Without access to either endpoint the fetch fails
+	var paths []string
+	server := endpointAPI(t, nil, &paths)
+	if _, err := fetchPages(server.URL, 0, defaultConfig()); err == nil {
+		t.Error("a fetch with both endpoints refused succeeded")
+	}
+}
+
+// TestFetchPagesPerPage checks that requests carry the configured per_page and that paging
+// a finite list in small pages stops at the short last page
+func TestFetchPagesPerPage(t *testing.T) {
+	t.Setenv("BALLDONTLIE_API_KEY", "")
+	const total = 7
+	var mu sync.Mutex
+	var perPages []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		perPages = append(perPages, r.URL.Query().Get("per_page"))
+		mu.Unlock()
+		cursor, _ := strconv.Atoi(r.URL.Query().Get("cursor"))
+		perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+		var response APIResponse
+		for id := cursor + 1; id <= min(cursor+perPage, total); id++ {
+			response.Data = append(response.Data, APIPlayer{ID: id, FirstName: "Test", LastName: fmt.Sprintf("Player%d", id)})
+		}
+		next := cursor + perPage
+		response.Meta.NextCursor = &next // Set even on the last page; the short page ends paging
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	config, err := parseTestFlags(t, "-per-page", "2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	players, err := fetchPages(server.URL, 0, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(players) != total {
+		t.Errorf("loaded %d players, want %d", len(players), total)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if want := []string{"2", "2", "2", "2"}; !reflect.DeepEqual(perPages, want) {
+		t.Errorf("per_page sent = %v, want %v", perPages, want)
+	}
+}
+
+// TestPerPageClamped checks that -per-page values outside the API's range are clamped
+func TestPerPageClamped(t *testing.T) {
+	tests := map[string]int{"0": 1, "-5": 1, "1": 1, "100": maxPerPage, "500": maxPerPage}
+	for value, want := range tests {
+		config, err := parseTestFlags(t, "-per-page", value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if config.PerPage != want {
+			t.Errorf("-per-page=%s gave %d, want %d", value, config.PerPage, want)
+		}
+	}
+}
+
+// TestFetchPagesNullTeam checks that a player the API sends with a null team loads as a free
+// agent, and that two free agents aren't treated as teammates
+func TestFetchPagesNullTeam(t *testing.T) {
+	t.Setenv("BALLDONTLIE_API_KEY", "")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": [
+			{"id": 1, "first_name": "Test", "last_name": "Signed", "position": "G", "team": {"id": 2, "full_name": "Boston Celtics", "abbreviation": "BOS"}},
+			{"id": 2, "first_name": "Test", "last_name": "Unsigned", "position": "F", "team": null},
+			{"id": 3, "first_name": "Test", "last_name": "Released", "position": "C", "team": null}
+		], "meta": {"next_cursor": null}}`)
+	}))
+	defer server.Close()
+
+	players, err := fetchPages(server.URL, 0, defaultConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	teams := make(map[string]string)
+	for _, player := range players {
+		teams[player.Name] = player.Team
+	}
+	want := map[string]string{"Test Signed": "Boston Celtics", "Test Unsigned": freeAgentTeam, "Test Released": freeAgentTeam}
+	if !reflect.DeepEqual(teams, want) {
+		t.Errorf("teams = %v, want %v", teams, want)
+	}
+	if status := teamStatus(freeAgentTeam, freeAgentTeam); status != MatchUnknown {
+		t.Errorf("two free agents compare as %v, want unknown", status)
+	}
+}
+
+// TestOfferAPIKeyScripted checks the key prompt with scripted input: a pasted key is saved to
+// .env and used, Enter falls back to the legends-only list unless -strict, and nothing is
+// asked when the session isn't interactive
+func TestOfferAPIKeyScripted(t *testing.T) {
+	useEnvFile(t, "OTHER=1\nBALLDONTLIE_API_KEY=your_api_key_here\n")
+	if err := offerAPIKey(defaultConfig(), inputLines("  abc123  "), true); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(".env")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(data); got != "OTHER=1\nBALLDONTLIE_API_KEY=abc123\n" {
+		t.Errorf(".env after pasting a key = %q", got)
+	}
+	if key := getAPIKey(false); key != "abc123" {
+		t.Errorf("getAPIKey() after pasting = %q, want abc123", key)
+	}
+
+	strict := defaultConfig()
+	strict.Strict = true
+	tests := []struct {
+		name        string
+		config      GameConfig
+		interactive bool
+		wantErr     bool
+		asks        bool
+	}{
+		{"enter", defaultConfig(), true, false, true},
+		{"enter with -strict", strict, true, true, true},
+		{"scripted", defaultConfig(), false, false, false},
+		{"scripted with -strict", strict, false, true, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			useEnvFile(t, "")
+			input := inputLines("", "next line")
+			err := offerAPIKey(test.config, input, test.interactive)
+			if (err != nil) != test.wantErr {
+				t.Errorf("offerAPIKey = %v, want error %v", err, test.wantErr)
+			}
+			if asked := <-input == "next line"; asked != test.asks {
+				t.Errorf("the prompt read a line: %v, want %v", asked, test.asks)
+			}
+			if key := getAPIKey(false); key != "" {
+				t.Errorf("getAPIKey() = %q, want no key saved", key)
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package main
+
+import (
+	"fmt"     // Package for formatted I/O operations
+	"sort"    // Package for sorting slices
+	"strings" // Package for string manipulation functions
+)
+
+// maxAssistValues is how many possible values the assist lists before summarizing with a count
+const maxAssistValues = 6
+
+// attributePossibilities returns, for each attribute, the sorted distinct values still possible
+// among the candidates
+func attributePossibilities(candidates []Player, config GameConfig) map[string][]string {
+	possible := make(map[string][]string)
+	for _, attribute := range comparedAttributes {
+		seen := make(map[string]bool)
+		for _, candidate := range candidates {
+			value := attributeValue(candidate, attribute, config)
+			if !seen[value] {
+				seen[value] = true
+				possible[attribute] = append(possible[attribute], value)
+			}
+		}
+		sort.Strings(possible[attribute])
+	}
+	return possible
+}
+
+// ruledOutValues returns the values guessed for an attribute that are no longer possible
+func ruledOutValues(history []GuessRecord, attribute string, possible []string, config GameConfig) []string {
+	stillPossible := make(map[string]bool)
+	for _, value := range possible {
+		stillPossible[value] = true
+	}
+	seen := make(map[string]bool)
+	var ruledOut []string
+	for _, record := range history {
+		value := attributeValue(record.Player, attribute, config)
+		if !stillPossible[value] && !seen[value] {
+			seen[value] = true
+			ruledOut = append(ruledOut, value)
+		}
+	}
+	return ruledOut
+}
+
+// printAssist shows, per attribute, which values are still possible and which guessed values
+// were ruled out, based on every guess so far
+func printAssist(game *Game) {
+	candidates := game.remainingCandidates()
+	possible := attributePossibilities(candidates, game.Config)
+
+	fmt.Printf("🧩 Assist (%d possible players):\n", len(candidates))
+	for _, attribute := range comparedAttributes {
+		if attribute == "name" {
+			continue
+		}
+		values := possible[attribute]
+		var summary string
+		switch {
+		case len(values) == 1:
+			summary = values[0] + " ✔"
+		case len(values) <= maxAssistValues:
+			summary = strings.Join(values, "/") + " possible"
+		default:
+			summary = fmt.Sprintf("%d values possible", len(values))
+		}
+		if ruledOut := ruledOutValues(game.History, attribute, values, game.Config); len(ruledOut) > 0 && len(values) > 1 {
+			summary += " (not " + strings.Join(ruledOut, ", not ") + ")"
+		}
+		fmt.Printf("  %-6s %s\n", attributeLabels[attribute]+":", summary)
+	}
+}
+
+// feedbackKey summarizes the markers a guess would get against a possible mystery player,
+// so candidates that would produce the same feedback can be grouped together
+func feedbackKey(guess, candidate Player, config GameConfig) string {
+	statuses := compareWithTarget(guess, candidate, config).Statuses
+	var key strings.Builder
+	for _, attribute := range comparedAttributes {
+		key.WriteString(statuses[attribute].String())
+		key.WriteByte('|')
+	}
+	return key.String()
+}
+
+// suggestGuess picks the remaining candidate that splits the others most evenly: the guess
+// whose feedback leaves the smallest expected number of players still possible
+// Returns false when no candidate is left
+func suggestGuess(candidates []Player, config GameConfig) (Player, bool) {
+	var best Player
+	bestScore := -1
+	for _, guess := range candidates {
+		groups := make(map[string]int)
+		for _, candidate := range candidates {
+			groups[feedbackKey(guess, candidate, config)]++
+		}
+		// The sum of squared group sizes is proportional to the expected players left after guessing
+		score := 0
+		for _, size := range groups {
+			score += size * size
+		}
+		if bestScore < 0 || score < bestScore || (score == bestScore && playerLess(guess, best)) {
+			best, bestScore = guess, score
+		}
+	}
+	return best, bestScore >= 0
+}
+
+// printSuggestion proposes a strong next guess from the players still possible
+func printSuggestion(game *Game) {
+	candidates := game.remainingCandidates()
+	suggestion, found := suggestGuess(candidates, game.Config)
+	if !found {
+		fmt.Println("🤔 No player matches every clue so far - no suggestion available.")
+		return
+	}
+	if len(candidates) == 1 {
+		fmt.Printf("🎯 Only one player is left: try %s\n", suggestion.Name)
+		return
+	}
+	fmt.Printf("🧭 Suggestion: %s splits the %d possible players most evenly.\n", suggestion.Name, len(candidates))
+}
+
+// diffColumnWidth is the width of each player's column in a 'diff' side-by-side
+const diffColumnWidth = 24
+
+// guessedPlayerNamed finds an already-guessed player by full name, first or last name, or
+// nickname; a query matching several guesses is ambiguous and finds nothing
+func guessedPlayerNamed(history []GuessRecord, query string) (GuessRecord, bool) {
+	query = normalizeName(query)
+	var matches []GuessRecord
+	for _, record := range history {
+		names := append(strings.Fields(normalizeName(record.Player.Name)), normalizeName(record.Player.Name))
+		for _, nickname := range record.Player.Nicknames {
+			names = append(names, normalizeName(nickname))
+		}
+		for _, name := range names {
+			if name == query {
+				if len(matches) == 0 || !samePlayer(matches[len(matches)-1].Player, record.Player) {
+					matches = append(matches, record)
+				}
+				break
+			}
+		}
+	}
+	if len(matches) != 1 {
+		return GuessRecord{}, false
+	}
+	return matches[0], true
+}
+
+// parseDiffPlayers splits the words after 'diff' into two guessed players, trying every split
+// so both "Curry Tatum" and "Stephen Curry Jayson Tatum" work; a comma or "vs" can also separate them
+func parseDiffPlayers(history []GuessRecord, words []string) (GuessRecord, GuessRecord, error) {
+	var parts []string
+	for _, word := range words {
+		for _, piece := range strings.Split(word, ",") {
+			if piece != "" && !strings.EqualFold(piece, "vs") {
+				parts = append(parts, piece)
+			}
+		}
+	}
+	if len(parts) < 2 {
+		return GuessRecord{}, GuessRecord{}, fmt.Errorf("name two of your guesses, e.g. 'diff Curry Tatum'")
+	}
+	for split := 1; split < len(parts); split++ {
+		first, foundFirst := guessedPlayerNamed(history, strings.Join(parts[:split], " "))
+		second, foundSecond := guessedPlayerNamed(history, strings.Join(parts[split:], " "))
+		if foundFirst && foundSecond {
+			if samePlayer(first.Player, second.Player) {
+				return GuessRecord{}, GuessRecord{}, fmt.Errorf("pick two different guesses to compare")
+			}
+			return first, second, nil
+		}
+	}
+	return GuessRecord{}, GuessRecord{}, fmt.Errorf("'%s' doesn't name two of your guesses - only players you've already guessed can be compared", strings.Join(words, " "))
+}
+
+// guessDiffLines lays out two guesses side by side with the markers each already earned, flagging
+// the attributes they share; it compares the guesses with each other, never with the mystery player
+func guessDiffLines(first, second GuessRecord, config GameConfig) []string {
+	theme := config.theme()
+	lines := []string{
+		fmt.Sprintf("🔍 %s vs %s (= same value)", first.Player.Name, second.Player.Name),
+		fmt.Sprintf("  %-14s %s   %s", "", fitColumn(strings.ToUpper(first.Player.Name), diffColumnWidth), fitColumn(strings.ToUpper(second.Player.Name), diffColumnWidth)),
+	}
+	for _, attribute := range comparedAttributes {
+		if attribute == "name" {
+			continue
+		}
+		firstValue := attributeValue(first.Player, attribute, config)
+		secondValue := attributeValue(second.Player, attribute, config)
+		same := " "
+		if firstValue == secondValue {
+			same = "="
+		}
+		lines = append(lines, fmt.Sprintf("  %-14s %s %s %s", attributeDisplayNames[attribute],
+			fitColumn(theme.mark(first.Result.Statuses[attribute], firstValue), diffColumnWidth), same,
+			fitColumn(theme.mark(second.Result.Statuses[attribute], secondValue), diffColumnWidth)))
+	}
+	return lines
+}
+
+// printGuessDiff shows two guesses side by side
+func printGuessDiff(first, second GuessRecord, config GameConfig) {
+	for _, line := range guessDiffLines(first, second, config) {
+		fmt.Println(line)
+	}
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"reflect" // Package for comparing value lists
+	"slices"  // Package for searching the candidate list
+	"strings" // Package for string manipulation functions
+	"testing" // Package for Go tests
+)
+
+// assistPool returns four players who differ only in name, team, position, college, and country
+func assistPool() []Player {
+	player := func(name, team, position, college, country string) Player {
+		p, _ := comparePlayers(func(p *Player) {
+			p.Name, p.Team, p.Position, p.College, p.Country = name, team, position, college, country
+		}, nil)
+		return p
+	}
+	return []Player{
+		player("Test Alpha", "Boston Celtics", "PG", "Duke", "USA"),
+		player("Test Bravo", "Chicago Bulls", "PG", "Kentucky", "USA"),
+		player("Test Charlie", "Boston Celtics", "C", "Duke", "Canada"),
+		player("Test Delta", "Denver Nuggets", "C", "UCLA", "France"),
+	}
+}
+
+// TestAttributePossibilitiesNarrow checks that each guess narrows the possible values of each
+// attribute and lists the guessed values it ruled out
+func TestAttributePossibilitiesNarrow(t *testing.T) {
+	pool := assistPool()
+	usePlayers(t, pool)
+	game, _ := newTestGame(t, nil)
+	game.Target = pool[0]
+
+	steps := []struct {
+		guess    Player
+		possible map[string][]string
+		ruledOut map[string][]string
+	}{
+		{pool[3], map[string][]string{
+			"position": {"PG"},
+			"college":  {"Duke", "Kentucky"},
+			"country":  {"USA"},
+			"team":     {"Boston Celtics", "Chicago Bulls"},
+		}, map[string][]string{
+			"position": {"C"},
+			"college":  {"UCLA"},
+			"country":  {"France"},
+			"team":     {"Denver Nuggets"},
+		}},
+		{pool[1], map[string][]string{
+			"position": {"PG"},
+			"college":  {"Duke"},
+			"team":     {"Boston Celtics"},
+		}, map[string][]string{
+			"position": {"C"},
+			"college":  {"UCLA", "Kentucky"},
+			"team":     {"Denver Nuggets", "Chicago Bulls"},
+		}},
+	}
+	for i, step := range steps {
+		game.recordGuess(step.guess)
+		possible := attributePossibilities(game.remainingCandidates(), game.Config)
+		for attribute, want := range step.possible {
+			if !reflect.DeepEqual(possible[attribute], want) {
+				t.Errorf("after guess %d: %s possible = %v, want %v", i+1, attribute, possible[attribute], want)
+			}
+			got := ruledOutValues(game.History, attribute, possible[attribute], game.Config)
+			if !reflect.DeepEqual(got, step.ruledOut[attribute]) {
+				t.Errorf("after guess %d: %s ruled out = %v, want %v", i+1, attribute, got, step.ruledOut[attribute])
+			}
+		}
+	}
+}
+
+// TestSuggestionIsCandidate checks that every suggestion is a player still consistent with the
+// clues, that the last candidate is suggested outright, and that no candidates means no suggestion
+func TestSuggestionIsCandidate(t *testing.T) {
+	pool := assistPool()
+	usePlayers(t, pool)
+	game, _ := newTestGame(t, nil)
+	game.Target = pool[0]
+
+	for _, guess := range []Player{pool[3], pool[1], pool[2]} {
+		game.recordGuess(guess)
+		candidates := game.remainingCandidates()
+		suggestion, found := suggestGuess(candidates, game.Config)
+		if !found {
+			t.Fatalf("after guessing %s: no suggestion from %d candidates", guess.Name, len(candidates))
+		}
+		if !slices.ContainsFunc(candidates, func(p Player) bool { return p.Name == suggestion.Name }) {
+			t.Errorf("after guessing %s: suggested %s, which isn't a remaining candidate", guess.Name, suggestion.Name)
+		}
+	}
+	if candidates := game.remainingCandidates(); len(candidates) != 1 || candidates[0].Name != pool[0].Name {
+		t.Fatalf("remaining candidates = %v, want only %s", candidates, pool[0].Name)
+	}
+	if suggestion, _ := suggestGuess(game.remainingCandidates(), game.Config); suggestion.Name != pool[0].Name {
+		t.Errorf("last candidate suggestion = %s, want %s", suggestion.Name, pool[0].Name)
+	}
+	if _, found := suggestGuess(nil, game.Config); found {
+		t.Error("suggestGuess(nil) found a suggestion")
+	}
+}
+
+// TestGuessDiff checks that 'diff' finds two guesses however they're named and lays them out
+// side by side with their markers, flagging exactly the values they share
+func TestGuessDiff(t *testing.T) {
+	pool := assistPool()
+	usePlayers(t, pool)
+	game, _ := newTestGame(t, nil)
+	game.Target = pool[0]
+	game.recordGuess(pool[1]) // Test Bravo: Chicago Bulls, PG, Kentucky, USA
+	game.recordGuess(pool[2]) // Test Charlie: Boston Celtics, C, Duke, Canada
+
+	for _, words := range [][]string{{"Bravo", "Charlie"}, {"Test", "Bravo", "vs", "Test", "Charlie"}, {"bravo,", "charlie"}} {
+		first, second, err := parseDiffPlayers(game.History, words)
+		if err != nil || first.Player.Name != "Test Bravo" || second.Player.Name != "Test Charlie" {
+			t.Errorf("parseDiffPlayers(%q) = %s, %s, %v", words, first.Player.Name, second.Player.Name, err)
+		}
+	}
+	for _, words := range [][]string{{"Bravo"}, {"Bravo", "Bravo"}, {"Bravo", "Delta"}, {"Test", "Bravo"}} {
+		if _, _, err := parseDiffPlayers(game.History, words); err == nil {
+			t.Errorf("parseDiffPlayers(%q) found two guesses", words)
+		}
+	}
+
+	lines := guessDiffLines(game.History[0], game.History[1], game.Config)
+	if len(lines) != len(comparedAttributes)+1 {
+		t.Fatalf("got %d lines, want a header, the names, and one row per attribute:\n%s", len(lines), strings.Join(lines, "\n"))
+	}
+	if lines[0] != "🔍 Test Bravo vs Test Charlie (= same value)" {
+		t.Errorf("header = %q", lines[0])
+	}
+	rows := make(map[string]string)
+	for _, line := range lines[2:] {
+		for attribute, label := range attributeDisplayNames {
+			if strings.HasPrefix(strings.TrimSpace(line), label+" ") {
+				rows[attribute] = line
+			}
+		}
+	}
+	theme := game.Config.theme()
+	for attribute, same := range map[string]bool{"team": false, "position": false, "college": false, "country": false, "height": true, "weight": true, "draftyear": true} {
+		row := rows[attribute]
+		if got := strings.Contains(row, " = "); got != same {
+			t.Errorf("%s row %q: flagged as same = %v, want %v", attribute, row, got, same)
+		}
+	}
+	if row := rows["team"]; !strings.Contains(row, theme.mark(MatchMiss, "Chicago Bulls")) || !strings.Contains(row, theme.mark(MatchExact, "Boston Celtics")) {
+		t.Errorf("team row %q doesn't show each guess's own marker", row)
+	}
+}
@@ -0,0 +1,204 @@
+package main
+
+import (
+	"fmt"     // Package for formatted I/O operations
+	"sort"    // Package for sorting slices
+	"strings" // Package for string manipulation functions
+)
+
+// attributeAliases maps the words accepted in attribute mode to attribute names
+var attributeAliases = map[string]string{
+	"team":         "team",
+	"position":     "position",
+	"pos":          "position",
+	"height":       "height",
+	"ht":           "height",
+	"weight":       "weight",
+	"wt":           "weight",
+	"college":      "college",
+	"draft year":   "draftyear",
+	"draftyear":    "draftyear",
+	"year":         "draftyear",
+	"draft round":  "draftround",
+	"draftround":   "draftround",
+	"round":        "draftround",
+	"draft pick":   "draftnumber",
+	"draftnumber":  "draftnumber",
+	"pick":         "draftnumber",
+	"jersey":       "jerseynumber",
+	"jerseynumber": "jerseynumber",
+	"number":       "jerseynumber",
+	"country":      "country",
+}
+
+// parseAttributeGuess splits input like "position: C" into an attribute name and value
+// Returns false if the input has no colon, so it can be treated as a player name
+func parseAttributeGuess(input string) (string, string, bool) {
+	parts := strings.SplitN(input, ":", 2)
+	if len(parts) != 2 {
+		return "", "", false
+	}
+	return strings.ToLower(strings.TrimSpace(parts[0])), strings.TrimSpace(parts[1]), true
+}
+
+// attributeMatches reports whether a guessed value matches a player's attribute,
+// ignoring case, accents, and punctuation
+func attributeMatches(player Player, attribute, value string, config GameConfig) bool {
+	actual := attributeValue(player, attribute, config)
+	switch attribute {
+	case "height":
+		// Accept 6'9 as well as 6'9"
+		guessInches, ok := heightInches(value)
+		actualInches, actualOK := heightInches(actual)
+		if ok && actualOK {
+			return guessInches == actualInches
+		}
+	case "jerseynumber":
+		value = strings.TrimPrefix(value, "#")
+	}
+	return normalizeName(value) == normalizeName(actual)
+}
+
+// countMatchingPlayers counts the players in the pool that match every pinned attribute
+func countMatchingPlayers(pinned map[string]string, config GameConfig) int {
+	count := 0
+	for _, player := range store.Players() {
+		matchesAll := true
+		for attribute, value := range pinned {
+			if !attributeMatches(player, attribute, value, config) {
+				matchesAll = false
+				break
+			}
+		}
+		if matchesAll {
+			count++
+		}
+	}
+	return count
+}
+
+// attributeNames returns the accepted attribute words in sorted order
+func attributeNames() []string {
+	names := make([]string, 0, len(attributeAliases))
+	for alias := range attributeAliases {
+		names = append(names, alias)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// playAttributeGame runs one game in attribute mode: each attempt guesses a single attribute
+// such as "position: C" or names a player, and the game is won by naming the mystery player
+func playAttributeGame(game *Game, input <-chan string) GameOutcome {
+	target := game.Target
+	if game.Pinned == nil {
+		game.Pinned = make(map[string]string)
+	}
+
+	for game.attemptsLeft() > 0 {
+		// Check if time has run out
+		if game.timeExpired() {
+			fmt.Printf("\n⏰ TIME'S UP! You ran out of time after %s.\n", formatDuration(game.elapsed(), game.Config))
+			return endOnTimeUp(game, input)
+		}
+
+		if game.isTimed() {
+			fmt.Printf("\nAttempt %s - Time remaining: %s - Guess 'attribute: value' or a player: ",
+				game.attemptLabel(), formatTimeRemaining(game.timeRemaining()))
+		} else {
+			fmt.Printf("\nAttempt %s - Guess 'attribute: value' or a player: ", game.attemptLabel())
+		}
+
+		var guess string
+		select {
+		case line, ok := <-input:
+			if !ok {
+				line = "quit" // Treat the end of input like typing 'quit'
+			}
+			guess = strings.TrimSpace(line)
+		case <-game.paceTimeout():
+			fmt.Printf("\n🐢 Too slow! No guess within %s - %s.\n", game.Config.Pace, game.applyPacePenalty())
+			continue
+		case <-game.timeout():
+			fmt.Printf("\n⏰ TIME'S UP! You ran out of time.\n")
+			return endOnTimeUp(game, input)
+		}
+
+		switch strings.ToLower(guess) {
+		case "":
+			continue
+		case "quit":
+			return quitAndSave(game) // The pinned attributes are saved with the game
+		case "attributes":
+			fmt.Println("Attributes:", strings.Join(attributeNames(), ", "))
+			continue
+		case "hint":
+			if game.hintsLeft() <= 0 {
+				fmt.Printf("❌ You've already used all %d hints!\n", game.Config.MaxHints)
+				continue
+			}
+			for attribute := range game.Pinned {
+				game.UsedHintAttributes[attribute] = true // Don't spend a hint on what's already pinned
+			}
+			if !showUniqueRandomAttributeHint(target, game.HintsUsed+1, game.UsedHintAttributes, game.Config) {
+				fmt.Printf("❌ All available attributes have already been revealed - your %d remaining hint(s) can't be used.\n", game.hintsLeft())
+				continue
+			}
+			game.HintsUsed++
+			fmt.Printf("💡 Hints remaining: %d\n", game.hintsLeft())
+			continue
+		}
+
+		theme := game.Config.theme()
+		if alias, value, isAttribute := parseAttributeGuess(guess); isAttribute {
+			attribute, known := attributeAliases[alias]
+			if !known {
+				fmt.Printf("❌ Unknown attribute '%s'. Type 'attributes' to list them.\n", alias)
+				continue // Don't count this as an attempt
+			}
+			if _, done := game.Pinned[attribute]; done {
+				fmt.Printf("✅ You've already pinned %s: %s\n", alias, game.Pinned[attribute])
+				continue
+			}
+
+			game.Attempts++
+			game.PaceStart = game.now() // A guess starts a new pace window
+			if attributeMatches(target, attribute, value, game.Config) {
+				game.Pinned[attribute] = attributeValue(target, attribute, game.Config)
+				fmt.Println(theme.mark(MatchExact, fmt.Sprintf("%s: %s", alias, game.Pinned[attribute])))
+				fmt.Printf("🔎 %d player(s) match everything you've pinned.\n", countMatchingPlayers(game.Pinned, game.Config))
+			} else {
+				fmt.Println(theme.mark(MatchMiss, fmt.Sprintf("%s: %s", alias, value)))
+			}
+		} else {
+			guessedPlayer, found := findPlayerByName(guess, game.Config)
+			if !found && game.Config.Hardcore {
+				game.Attempts++
+				game.PaceStart = game.now() // A guess starts a new pace window
+				fmt.Printf("❌ Player '%s' not found - that costs an attempt in hardcore mode.\n", guess)
+				continue
+			}
+			if !found {
+				fmt.Printf("❌ Player '%s' not found. Guess an attribute like 'position: C' or a player name.\n", guess)
+				continue // Don't count this as an attempt
+			}
+			if !isCandidate(*guessedPlayer, game.Config) {
+				fmt.Printf("❌ %s isn't one of the candidates - no attempt used.\n", guessedPlayer.Name)
+				continue
+			}
+
+			game.Attempts++
+			game.PaceStart = game.now() // A guess starts a new pace window
+			if game.isCorrect(*guessedPlayer) {
+				printVictory(game)
+				return OutcomeWon
+			}
+			fmt.Println(theme.mark(MatchMiss, guessedPlayer.Name))
+		}
+	}
+
+	// Game over - every attempt was used without naming the player
+	fmt.Printf("\n💔 Game Over! You've used all %d attempts in %s.\n", game.Config.MaxAttempts, formatDuration(game.elapsed(), game.Config))
+	revealOnLoss(game, input)
+	return OutcomeLost
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"testing" // Package for Go tests
+)
+
+// newAttributeGame starts an attribute-mode game on a fake clock, with the fallback players loaded
+func newAttributeGame(t *testing.T, maxAttempts int) *Game {
+	t.Helper()
+	useFallbackPlayers(t)
+	game, _ := newTestGame(t, func(c *GameConfig) {
+		c.Mode = "attributes"
+		c.MaxAttempts = maxAttempts
+		c.SaveFile = t.TempDir() + "/save.json"
+	})
+	return game
+}
+
+// TestAttributeGameWinCondition checks that only naming the mystery player wins: pinning
+// attributes narrows the field but never ends the game
+func TestAttributeGameWinCondition(t *testing.T) {
+	game := newAttributeGame(t, 4)
+	position := "position: " + game.Target.Position
+	college := "college: " + game.Target.College
+	outcome := playAttributeGame(game, inputLines(position, college, game.Target.Name))
+	if outcome != OutcomeWon || game.Attempts != 3 {
+		t.Errorf("pinning two attributes then naming the player: %v in %d attempts, want a win in 3", outcome, game.Attempts)
+	}
+	if len(game.Pinned) != 2 || game.Pinned["position"] != game.Target.Position {
+		t.Errorf("pinned = %v, want position and college", game.Pinned)
+	}
+}
+
+// TestAttributeGameLoss checks that right attributes alone still lose once the attempts run out
+func TestAttributeGameLoss(t *testing.T) {
+	game := newAttributeGame(t, 2)
+	outcome := playAttributeGame(game, inputLines("position: "+game.Target.Position, "country: "+game.Target.Country))
+	if outcome != OutcomeLost {
+		t.Errorf("two right attributes with two attempts: %v, want a loss", outcome)
+	}
+}
+
+// TestAttributeGameFreeInput checks what doesn't cost an attempt: unknown attributes, repeated
+// pins, and unknown player names
+func TestAttributeGameFreeInput(t *testing.T) {
+	game := newAttributeGame(t, 2)
+	pin := "team: " + game.Target.Team
+	outcome := playAttributeGame(game, inputLines("shoe size: 15", pin, pin, "Nobody Atall", testGuess(game).Name, game.Target.Name))
+	if outcome != OutcomeLost {
+		t.Fatalf("a pin and a wrong player with two attempts: %v, want a loss", outcome)
+	}
+	if game.Attempts != 2 {
+		t.Errorf("attempts = %d, want 2 (only the first pin and the wrong player count)", game.Attempts)
+	}
+}
+
+// TestAttributeGameHint checks that 'hint' spends the hint budget rather than an attempt, and
+// isn't taken for a player name
+func TestAttributeGameHint(t *testing.T) {
+	game := newAttributeGame(t, 2)
+	game.Config.MaxHints = 1
+	outcome := playAttributeGame(game, inputLines("hint", "HINT", game.Target.Name))
+	if outcome != OutcomeWon || game.Attempts != 1 {
+		t.Errorf("two hints then the answer: %v in %d attempts, want a win in 1", outcome, game.Attempts)
+	}
+	if game.HintsUsed != 1 || len(game.UsedHintAttributes) != 1 {
+		t.Errorf("hints used = %d revealing %v, want 1 (the second is over budget)", game.HintsUsed, game.UsedHintAttributes)
+	}
+}
+
+// TestAttributeGameHintSkipsPinned checks that a hint never reveals an attribute already pinned
+func TestAttributeGameHintSkipsPinned(t *testing.T) {
+	game := newAttributeGame(t, 3)
+	playAttributeGame(game, inputLines("position: "+game.Target.Position, "hint", "quit"))
+	if game.HintsUsed != 1 {
+		t.Fatalf("hints used = %d, want 1", game.HintsUsed)
+	}
+	revealed := 0
+	for attribute := range game.UsedHintAttributes {
+		if attribute != "position" {
+			revealed++
+		}
+	}
+	if revealed != 1 {
+		t.Errorf("the hint revealed %v, want one attribute besides the pinned position", game.UsedHintAttributes)
+	}
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"fmt"         // Package for formatted I/O operations
+	"os"          // Package for reading the terminal width from the environment
+	"strconv"     // Package for converting strings to numbers
+	"strings"     // Package for string manipulation functions
+	"sync/atomic" // Package for the width shared with the resize watcher
+	"time"        // Package for the typewriter delay
+)
+
+// maxTypewriterDelay keeps the typewriter effect short even with a large -typewriter-delay
+const maxTypewriterDelay = 50 * time.Millisecond
+
+// Card width bounds: wide enough for the longest labels, narrow enough to paste anywhere
+const (
+	minCardWidth = 32
+	maxCardWidth = 60
+)
+
+// resizedWidth holds the width measured after the last terminal resize (0 until the first resize)
+var resizedWidth atomic.Int64
+
+// handleResize stores the width reported by query after a resize signal; a failed query
+// keeps the previous width
+func handleResize(query func() (int, bool)) {
+	if width, ok := query(); ok {
+		resizedWidth.Store(int64(width))
+	}
+}
+
+// terminalWidth returns the width measured after the last resize, else $COLUMNS, else 80
+// ($COLUMNS is set once by the shell and goes stale when the window is resized)
+func terminalWidth() int {
+	if width := resizedWidth.Load(); width > 0 {
+		return int(width)
+	}
+	if columns, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && columns > 0 {
+		return columns
+	}
+	return 80
+}
+
+// cardWidth returns the outer width of a player card for the current terminal
+func cardWidth() int {
+	return max(minCardWidth, min(maxCardWidth, terminalWidth()))
+}
+
+// playerCard renders a boxed ASCII card of a player's attributes, width characters wide,
+// in the configured reveal order
+func playerCard(player Player, width int, config GameConfig) string {
+	inner := width - 4 // Borders and one space of padding on each side
+	lines := revealLines(orderReveal(cardSections(player, config.RevealOrder == "suspense"), config))
+
+	border := "+" + strings.Repeat("-", width-2) + "+"
+	var card strings.Builder
+	card.WriteString(border + "\n")
+	for _, line := range lines {
+		card.WriteString("| " + fitColumn(line, inner) + " |\n")
+	}
+	card.WriteString(border)
+	return card.String()
+}
+
+// showPlayerReveal shows the mystery player at the end of a game: a card normally, or the
+// plain details list in quiet mode
+func showPlayerReveal(player Player, config GameConfig) {
+	if config.Quiet {
+		printPlayerDetails(player, config)
+		return
+	}
+	fmt.Println()
+	card := playerCard(player, cardWidth(), config)
+	if typewriterEnabled(config, isTerminal(os.Stdout)) {
+		typewrite(card+"\n", min(config.TypewriterDelay, maxTypewriterDelay))
+		return
+	}
+	fmt.Println(card)
+}
+
+// typewriterEnabled reports whether the reveal should be typed out: only when asked for,
+// not in quiet mode, and only on a terminal so piped output isn't slowed down
+func typewriterEnabled(config GameConfig, terminal bool) bool {
+	return config.Typewriter && !config.Quiet && terminal
+}
+
+// cellRevealDelay is the pause before each cell of an animated comparison row
+const cellRevealDelay = 120 * time.Millisecond
+
+// animationEnabled reports whether comparison rows should be revealed cell by cell: only when
+// asked for, not in quiet mode, and only on a terminal
+func animationEnabled(config GameConfig, terminal bool) bool {
+	return config.AnimateCells && !config.Quiet && terminal
+}
+
+// revealRow prints a rendered row one cell at a time, left to right, then ends the line
+// Cells are the pieces between separators, so every layout can be animated the same way
+func revealRow(row, separator string, delay time.Duration) {
+	for i, cell := range strings.Split(row, separator) {
+		if i > 0 {
+			fmt.Print(separator)
+		}
+		time.Sleep(delay)
+		fmt.Print(cell)
+	}
+	fmt.Println()
+}
+
+// typewrite prints text one character at a time with a pause after each
+func typewrite(text string, delay time.Duration) {
+	for _, r := range text {
+		fmt.Print(string(r))
+		if r != ' ' && r != '-' {
+			time.Sleep(delay) // Runs of spaces and borders are printed at full speed
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"os"      // Package for checking whether stdout is a terminal
+	"strings" // Package for string manipulation functions
+	"testing" // Package for Go tests
+	"time"    // Package for timing the reveal
+)
+
+// cardLines renders a player card and checks that it is well-formed: every line exactly
+// width columns, bordered top and bottom, and boxed on both sides
+func cardLines(t *testing.T, player Player, width int, config GameConfig) []string {
+	t.Helper()
+	lines := strings.Split(playerCard(player, width, config), "\n")
+	border := "+" + strings.Repeat("-", width-2) + "+"
+	if lines[0] != border || lines[len(lines)-1] != border {
+		t.Errorf("card isn't closed by borders:\n%s", strings.Join(lines, "\n"))
+	}
+	for i, line := range lines {
+		if displayWidth(line) != width {
+			t.Errorf("line %q is %d columns wide, want %d", line, displayWidth(line), width)
+		}
+		if i > 0 && i < len(lines)-1 && (!strings.HasPrefix(line, "| ") || !strings.HasSuffix(line, " |")) {
+			t.Errorf("line %q isn't boxed", line)
+		}
+	}
+	return lines[1 : len(lines)-1]
+}
+
+// TestPlayerCard checks that the card shows every field of a sample player
+func TestPlayerCard(t *testing.T) {
+	player := Player{
+		Name: "Nikola Jokić", Team: "Denver Nuggets", IconicTeam: "Serbia National Team", Position: "C",
+		Height: "6'11\"", Weight: 284, College: "None", DraftYear: 2014, DraftRound: 2, DraftNumber: 41,
+		JerseyNumber: "15", Country: "Serbia", Nicknames: []string{"Joker"},
+	}
+	for _, order := range []string{"standard", "suspense"} {
+		config := defaultConfig()
+		config.RevealOrder = order
+		card := strings.Join(cardLines(t, player, maxCardWidth, config), "\n")
+		for _, field := range []string{
+			"NIKOLA JOKIĆ", "Team:     Denver Nuggets", "Iconic:   Serbia National Team", "Position: C",
+			"6'11\"", formatWeight(284), "College:  None", "Draft:    2014, round 2, pick #41", "#15", "Serbia", "A.k.a.:   Joker",
+		} {
+			if !strings.Contains(card, field) {
+				t.Errorf("%s card is missing %q:\n%s", order, field, card)
+			}
+		}
+	}
+
+	// An undrafted player's draft line says so
+	undrafted := player
+	undrafted.DraftRound, undrafted.DraftNumber = 0, 0
+	if card := strings.Join(cardLines(t, undrafted, maxCardWidth, defaultConfig()), "\n"); !strings.Contains(card, "Draft:    Undrafted") {
+		t.Errorf("undrafted card:\n%s", card)
+	}
+}
+
+// TestPlayerCardNarrow checks that the narrowest card cuts long values short and stays boxed
+func TestPlayerCardNarrow(t *testing.T) {
+	player := getFallbackPlayers()[0]
+	player.Team = "A Team Name Far Too Long For The Narrowest Card"
+	lines := cardLines(t, player, minCardWidth, defaultConfig())
+	if !strings.Contains(strings.Join(lines, "\n"), "…") {
+		t.Error("the long team name wasn't cut short")
+	}
+}
+
+// TestTypewriterOnlyOnTerminals checks that the typewriter and cell animation only run when
+// asked for, outside quiet mode, on a terminal
+func TestTypewriterOnlyOnTerminals(t *testing.T) {
+	tests := []struct {
+		enabled, quiet, terminal bool
+		want                     bool
+	}{
+		{true, false, true, true},
+		{true, false, false, false},
+		{true, true, true, false},
+		{false, false, true, false},
+	}
+	for _, test := range tests {
+		config := defaultConfig()
+		config.Typewriter, config.AnimateCells, config.Quiet = test.enabled, test.enabled, test.quiet
+		if got := typewriterEnabled(config, test.terminal); got != test.want {
+			t.Errorf("typewriterEnabled(enabled %v, quiet %v, terminal %v) = %v", test.enabled, test.quiet, test.terminal, got)
+		}
+		if got := animationEnabled(config, test.terminal); got != test.want {
+			t.Errorf("animationEnabled(enabled %v, quiet %v, terminal %v) = %v", test.enabled, test.quiet, test.terminal, got)
+		}
+	}
+}
+
+// TestRevealSkipsTypewriterWhenPiped checks that a reveal with the typewriter on prints at full
+// speed when output isn't a terminal, as under go test
+func TestRevealSkipsTypewriterWhenPiped(t *testing.T) {
+	if isTerminal(os.Stdout) {
+		t.Skip("stdout is a terminal")
+	}
+	config := defaultConfig()
+	config.Typewriter = true
+	config.TypewriterDelay = maxTypewriterDelay // Typed out, the card would take over a minute
+	start := time.Now()
+	showPlayerReveal(getFallbackPlayers()[0], config)
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("the piped reveal took %v", elapsed)
+	}
+}
+
+// TestResizeRereadsWidth checks that a simulated resize replaces the stale $COLUMNS width, and
+// that a failed width query keeps the last good one
+func TestResizeRereadsWidth(t *testing.T) {
+	t.Setenv("COLUMNS", "100")
+	resizedWidth.Store(0)
+	t.Cleanup(func() { resizedWidth.Store(0) })
+	if got := terminalWidth(); got != 100 {
+		t.Fatalf("terminalWidth before a resize = %d, want 100 from $COLUMNS", got)
+	}
+
+	queries := 0
+	resize := func(width int, ok bool) {
+		handleResize(func() (int, bool) {
+			queries++
+			return width, ok
+		})
+	}
+	resize(60, true)
+	if got := terminalWidth(); got != 60 {
+		t.Errorf("terminalWidth after resizing to 60 = %d", got)
+	}
+	if got := cardWidth(); got > 60 {
+		t.Errorf("cardWidth after resizing to 60 = %d, wider than the terminal", got)
+	}
+	resize(0, false)
+	if got := terminalWidth(); got != 60 {
+		t.Errorf("terminalWidth after a failed query = %d, want the last width 60", got)
+	}
+	resize(132, true)
+	if got := terminalWidth(); got != 132 {
+		t.Errorf("terminalWidth after resizing to 132 = %d", got)
+	}
+	if queries != 3 {
+		t.Errorf("the width was queried %d times for 3 resizes", queries)
+	}
+}
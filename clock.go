@@ -0,0 +1,24 @@
+package main
+
+import (
+	"time" // Package for time-related operations
+)
+
+// Clock tells the time and starts timers for a game, so timed rules can be tested without waiting
+type Clock interface {
+	Now() time.Time                                // The current time
+	After(duration time.Duration) <-chan time.Time // A channel that fires once the duration has passed
+}
+
+// systemClock is the wall clock every real game runs on
+type systemClock struct{}
+
+// Now returns the current wall-clock time
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// After fires once the duration has passed on the wall clock
+func (systemClock) After(duration time.Duration) <-chan time.Time {
+	return time.After(duration)
+}
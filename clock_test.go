@@ -0,0 +1,85 @@
+package main
+
+import (
+	"sync"    // Package for guarding the fake clock
+	"testing" // Package for Go tests
+	"time"    // Package for time-related operations
+)
+
+// fakeClock is a Clock that only moves when a test advances it
+type fakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	timers []fakeTimer
+}
+
+// fakeTimer is a pending After channel and when it fires
+type fakeTimer struct {
+	at time.Time
+	ch chan time.Time
+}
+
+// newFakeClock returns a fake clock stopped at a fixed time
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2024, time.March, 1, 20, 0, 0, 0, time.UTC)}
+}
+
+// Now returns the fake time
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// After returns a channel that fires once the clock is advanced past the duration
+func (c *fakeClock) After(duration time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ch := make(chan time.Time, 1)
+	if duration <= 0 {
+		ch <- c.now
+		return ch
+	}
+	c.timers = append(c.timers, fakeTimer{at: c.now.Add(duration), ch: ch})
+	return ch
+}
+
+// Advance moves the clock forward and fires every timer that is now due
+func (c *fakeClock) Advance(duration time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(duration)
+	pending := c.timers[:0]
+	for _, timer := range c.timers {
+		if timer.at.After(c.now) {
+			pending = append(pending, timer)
+			continue
+		}
+		timer.ch <- c.now
+	}
+	c.timers = pending
+}
+
+// fired reports whether a timer channel has fired, without waiting
+func fired(ch <-chan time.Time) bool {
+	select {
+	case <-ch:
+		return true
+	default:
+		return false
+	}
+}
+
+// TestFakeClockTimers checks the fake clock's own timers before other tests rely on them
+func TestFakeClockTimers(t *testing.T) {
+	clock := newFakeClock()
+	soon, later := clock.After(time.Second), clock.After(time.Minute)
+	clock.Advance(time.Second)
+	if !fired(soon) || fired(later) {
+		t.Fatal("advancing one second should fire only the one-second timer")
+	}
+	clock.Advance(time.Minute)
+	if !fired(later) {
+		t.Fatal("the one-minute timer never fired")
+	}
+}
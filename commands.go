@@ -0,0 +1,70 @@
+package main
+
+import (
+	"fmt"     // Package for formatted I/O operations
+	"sort"    // Package for sorting slices
+	"strings" // Package for string manipulation functions
+)
+
+// gameCommand describes one command that can be typed instead of a guess
+type gameCommand struct {
+	Name        string // What the player types
+	Description string // Shown by 'help'
+}
+
+// gameCommands lists the commands available during a game, in the order 'help' shows them
+var gameCommands = []gameCommand{
+	{Name: "hint", Description: "Reveal a random attribute of the mystery player"},
+	{Name: "hint X", Description: "Reveal a chosen attribute, e.g. 'hint college' (uses a hint)"},
+	{Name: "suggest", Description: "Propose a strong next guess (with -assist, no attempt used)"},
+	{Name: "diff A B", Description: "Compare two of your guesses side by side (with -assist)"},
+	{Name: "time", Description: "Trade one attempt for extra time (with -time-trade)"},
+	{Name: "info", Description: "Summarize what you know for sure: exact matches and revealed hints"},
+	{Name: "list", Description: "List every player you can guess"},
+	{Name: "legend", Description: "Explain the markers and every column of the table"},
+	{Name: "help", Description: "Show this list of commands"},
+	{Name: "skip", Description: "Swap a mystery player with missing data for a new one (once per game)"},
+	{Name: "giveup", Description: "End the game and reveal the answer"},
+	{Name: "quit", Description: "Save the game and exit (continue later with -resume)"},
+}
+
+// printCommands lists the in-game commands
+func printCommands() {
+	fmt.Println("Type a player's name to guess, or one of these commands:")
+	for _, command := range gameCommands {
+		fmt.Printf("  %-8s %s\n", command.Name, command.Description)
+	}
+}
+
+// looksLikeCommand reports whether unmatched input is a single word, which is more likely
+// a mistyped command than a player's name
+func looksLikeCommand(input string) bool {
+	return input != "" && !strings.ContainsAny(input, " \t")
+}
+
+// printPlayerList prints every guessable player's name in alphabetical order, wrapped to the terminal
+func printPlayerList() {
+	names := getAllPlayerNames()
+	sort.Strings(names)
+	fmt.Printf("%s players:\n", formatCount(len(names)))
+
+	width := terminalWidth()
+	line := ""
+	for i, name := range names {
+		entry := name
+		if i < len(names)-1 {
+			entry += ","
+		}
+		if line != "" && len([]rune(line))+1+len([]rune(entry)) > width {
+			fmt.Println(line)
+			line = ""
+		}
+		if line != "" {
+			line += " "
+		}
+		line += entry
+	}
+	if line != "" {
+		fmt.Println(line)
+	}
+}
@@ -0,0 +1,21 @@
+package main
+
+import (
+	"testing" // Package for Go tests
+)
+
+// TestLooksLikeCommand checks which unknown inputs are taken for command typos rather than names
+func TestLooksLikeCommand(t *testing.T) {
+	tests := map[string]bool{
+		"hnt":          true,
+		"sugest":       true,
+		"":             false,
+		"Nobody Atall": false,
+		"de\tla":       false,
+	}
+	for input, want := range tests {
+		if got := looksLikeCommand(input); got != want {
+			t.Errorf("looksLikeCommand(%q) = %v, want %v", input, got, want)
+		}
+	}
+}
@@ -0,0 +1,480 @@
+package main
+
+import (
+	"flag"      // Package for command-line flag parsing
+	"fmt"       // Package for formatted I/O operations
+	"io"        // Package for the writer flag problems are printed to
+	"math/rand" // Package for generating random numbers
+	"os"        // Package for standard error
+	"slices"    // Package for searching the allowed values of a setting
+	"strings"   // Package for string manipulation functions
+	"time"      // Package for time-related operations
+)
+
+// GameConfig holds every setting that controls how a game is played
+type GameConfig struct {
+	MaxAttempts           int             // Maximum number of guesses allowed
+	MaxHints              int             // Maximum number of hints allowed
+	HintOrder             string          // How hints are chosen: "random" or "ladder"
+	HintLadder            []string        // Attributes revealed in order when HintOrder is "ladder"
+	RevealOrder           string          // Order of the end-of-game reveal: "standard" or "suspense" (weakest to strongest)
+	HintMinShared         int             // Hints may only reveal values shared by at least this many players (0 disables)
+	TimeLimit             time.Duration   // Total time allowed to solve the puzzle
+	UnlimitedAttempts     bool            // Ignore MaxAttempts and keep guessing until solved
+	SmallPool             string          // What to do when the pool is no bigger than MaxAttempts: adjust, warn, or off
+	NoTimeLimit           bool            // Ignore TimeLimit and never time out
+	Zen                   bool            // No timer and unlimited attempts (sets NoTimeLimit and UnlimitedAttempts)
+	Endurance             time.Duration   // Shared time budget for solving as many players as possible (0 for normal games)
+	SessionTime           time.Duration   // Stop offering new games once the session has run this long (0 for no cap)
+	TimeTrade             time.Duration   // Extra time the 'time' command buys for one attempt (0 disables the command)
+	Pace                  time.Duration   // Longest allowed gap between guesses before a penalty (0 disables pacing)
+	Async                 bool            // Casual multi-day play: no timer, and the answer is never revealed by a clock
+	Difficulty            string          // Preset the limits and tolerances were taken from (easy, normal, hard)
+	DraftYearTolerance    int             // Draft years within this many years are a close match
+	DraftPickTolerance    int             // Draft picks within this many picks are a close match
+	Quiz                  bool            // Ask a bonus multiple-choice question about the mystery player after the reveal
+	HistoryLimit          int             // Most earlier guesses shown when a board is reprinted (0 shows all)
+	TeamArt               bool            // Give team hints as an ASCII logo silhouette where one exists
+	PickGap               string          // How a close pick's gap is shown: "off", "number", "arrow", or "bucket"
+	HeightToleranceInches int             // Heights within this many inches are a close match
+	WeightToleranceLbs    int             // Weights within this many pounds are a close match
+	SimilarityWeights     map[string]int  // Points per shared attribute used by Similarity, keyed by attribute name
+	NameHints             []nameHint      // Automatic name hints and when they fire (empty disables them)
+	PlayersFiles          stringList      // Custom JSON player files to load instead of the API
+	PlayersCSVFiles       stringList      // Custom CSV player files to load instead of the API (after the JSON files)
+	MaxPages              int             // Number of pages fetched from the API (PerPage players each)
+	PerPage               int             // Players requested per API page (1-100)
+	ProbeTimeout          time.Duration   // How long the offline check waits for the API before falling back (0 skips it)
+	Historical            bool            // Fetch every player in NBA history instead of only active players
+	ExcludeUnknown        bool            // Drop players with an unknown position from the pool entirely
+	IncludeTwoWay         bool            // Allow players on two-way contracts to be the mystery player
+	AvoidRecent           int             // How many recent mystery players to skip when picking a new one (0 disables)
+	RecentTargets         []string        // Names of the latest random mystery players, oldest first (loaded from the stats file)
+	ExcludedTargets       map[string]bool // Normalized names of players who are never the mystery player
+	Candidates            []string        // Normalized names the mystery player is chosen from and guesses are limited to (empty for everyone)
+	DraftClass            int             // Only pick the mystery player from this draft year (0 for any year)
+	DraftClassOnly        bool            // Also limit the guessable pool to DraftClass
+	SaveFile              string          // Path the game is written to when the player quits
+	StatsFile             string          // Path of the persistent stats file
+	H2HName               string          // Name daily and weekly results are recorded under for head-to-head ("" disables it)
+	H2HFile               string          // Path of the shared head-to-head file
+	H2HReport             bool            // Print the head-to-head report and exit
+	TrackPlayers          bool            // Record per-player guess and mystery-player counts in the stats file
+	Analytics             bool            // Print the per-player analytics report and exit
+	ReplayFile            string          // Path each finished game is written to as a replay ("" disables it)
+	PlayReplay            string          // Path of a replay to watch instead of playing ("" to play normally)
+	CSVFile               string          // Path the session's guesses are exported to ("" disables the export)
+	Daily                 bool            // Play the date-seeded daily challenge
+	TargetName            string          // Force this player as the first mystery player, for reproducing reports ("" picks randomly)
+	Weekly                bool            // Play the next puzzle of the week-seeded weekly challenge
+	Resume                bool            // Resume the game stored in SaveFile instead of starting a new one
+	Verbose               bool            // Print detailed loading and debug output
+	Strict                bool            // Fail instead of falling back to the legends-only list when there's no API key
+	Quiet                 bool            // Turn off encouragement and taunt messages
+	NoSpoil               bool            // Keep the answer hidden after a loss until the player types 'reveal'
+	AllowRepeats          bool            // Guess already-guessed players without a confirmation prompt
+	FuzzyDistance         int             // Largest edit distance a misspelled name may have and still match (0 disables)
+	NoFuzzy               bool            // Accept only exact names and nicknames
+	ExactNames            bool            // Accept only full names, not even nicknames
+	Hardcore              bool            // Charge an attempt for names that aren't found and drop the automatic name hints
+	NoTransliteration     bool            // Don't rewrite phonetic spellings like "Yokic" to the pool's spelling
+	NoReplayPrompt        bool            // Exit after one game instead of asking to play again
+	NoMenu                bool            // Skip the interactive settings menu at startup
+	StarterClue           bool            // Reveal one weak attribute for free at the start of each game
+	Theme                 string          // Name of the marker theme used in comparisons
+	Rand                  *rand.Rand      // Source of every random choice in the session: targets, hints, and messages
+	Clock                 Clock           // Source of the time for deadlines, splits, and the pace (nil means the wall clock)
+	Locale                string          // Locale code for durations and number formatting (en, es, fr, de)
+	Durations             string          // How messages and summaries write durations: "verbose" or "compact"
+	Compact               bool            // Show each guess as a single line of labeled markers
+	CompactOrder          string          // Attribute order of compact lines: "priority" (most informative first) or "fixed"
+	Blind                 bool            // Show only match markers, hiding the guessed player's values
+	Format                string          // End-of-game summary: "json", "text", or "" to decide by whether input is scripted
+	Separator             string          // Column separator in the table: bars (" | ") or spaces ("  ")
+	Border                bool            // Draw a box around the table and between its rows
+	AnimateCells          bool            // Reveal each guess's cells left to right with a short delay
+	Typewriter            bool            // Type out the final answer card character by character
+	TypewriterDelay       time.Duration   // Pause after each character of the typewriter effect
+	TimeSplits            bool            // Show how long each guess took and the average per guess
+	ShowRemaining         bool            // Show how many players are still consistent with the clues after each guess
+	Legend                bool            // Explain the markers and table columns before the first game
+	StrictPositions       bool            // Treat generic positions (G, F) as a miss against specific ones instead of close
+	Heat                  bool            // Show an overall closeness percentage after each guess
+	Delta                 bool            // Show which markers changed compared with the previous guess
+	Assist                bool            // Show the values of each attribute still possible after each guess
+	TeamMode              string          // Which team is compared: "current" or "iconic"
+	Mode                  string          // Game mode: "player" (guess players), "attributes" (guess single attributes), or "team" (guess a team)
+}
+
+// nameHint schedules an automatic name hint of the given level after the given number of guesses
+type nameHint struct {
+	Attempt int // Number of valid guesses after which the hint is shown
+	Level   int // Hint level passed to getNameHint
+}
+
+// maxPagesLimit caps -max-pages; at 100 players per page this is far beyond every real player
+const maxPagesLimit = 100
+
+// maxPerPage is the largest page size the Ball Don't Lie API accepts
+const maxPerPage = 100
+
+// difficultyPreset holds the limits and closeness tolerances for one difficulty level
+type difficultyPreset struct {
+	MaxAttempts           int
+	MaxHints              int
+	TimeLimit             time.Duration
+	DraftYearTolerance    int
+	DraftPickTolerance    int
+	HeightToleranceInches int
+	WeightToleranceLbs    int
+	NameHints             []nameHint
+}
+
+// difficultyPresets lists every difficulty selectable with -difficulty
+var difficultyPresets = map[string]difficultyPreset{
+	"easy":   {MaxAttempts: 10, MaxHints: 5, TimeLimit: 10 * time.Minute, DraftYearTolerance: 3, DraftPickTolerance: 8, HeightToleranceInches: 2, WeightToleranceLbs: 15, NameHints: []nameHint{{Attempt: 4, Level: 1}, {Attempt: 6, Level: 2}, {Attempt: 9, Level: 3}}},
+	"normal": {MaxAttempts: 8, MaxHints: 3, TimeLimit: 6 * time.Minute, DraftYearTolerance: 2, DraftPickTolerance: 5, HeightToleranceInches: 1, WeightToleranceLbs: 10, NameHints: []nameHint{{Attempt: 4, Level: 1}, {Attempt: 6, Level: 2}, {Attempt: 7, Level: 3}}},
+	"hard":   {MaxAttempts: 6, MaxHints: 1, TimeLimit: 4 * time.Minute, DraftYearTolerance: 1, DraftPickTolerance: 3, HeightToleranceInches: 0, WeightToleranceLbs: 5, NameHints: nil},
+}
+
+// applyDifficulty copies a difficulty preset into the config
+func (c *GameConfig) applyDifficulty(name string) error {
+	preset, found := difficultyPresets[strings.ToLower(name)]
+	if !found {
+		return fmt.Errorf("unknown difficulty %q (choose easy, normal, or hard)", name)
+	}
+	c.Difficulty = strings.ToLower(name)
+	c.MaxAttempts = preset.MaxAttempts
+	c.MaxHints = preset.MaxHints
+	c.TimeLimit = preset.TimeLimit
+	c.DraftYearTolerance = preset.DraftYearTolerance
+	c.DraftPickTolerance = preset.DraftPickTolerance
+	c.HeightToleranceInches = preset.HeightToleranceInches
+	c.WeightToleranceLbs = preset.WeightToleranceLbs
+	c.NameHints = preset.NameHints
+	return nil
+}
+
+// defaultConfig returns the settings used when no flags are given
+func defaultConfig() GameConfig {
+	config := GameConfig{
+		Rand:              rand.New(rand.NewSource(time.Now().UnixNano())),
+		Theme:             "default",
+		Separator:         "bars",
+		TeamMode:          "current",
+		Mode:              "player",
+		Locale:            "en",
+		Durations:         durationVerbose,
+		FuzzyDistance:     2, // Forgives a dropped or swapped letter
+		HintOrder:         "random",
+		SmallPool:         "adjust",
+		RevealOrder:       "standard",
+		CompactOrder:      "priority",
+		PickGap:           "off",
+		HistoryLimit:      10,
+		HintLadder:        defaultHintLadder,
+		SaveFile:          ".hoop-detective-save.json", // Saved next to the .env file
+		StatsFile:         defaultStatsFile(),
+		H2HFile:           defaultH2HFile(),
+		MaxPages:          10, // About 1,000 players, within the free API tier's rate limits
+		PerPage:           maxPerPage,
+		ProbeTimeout:      2 * time.Second,
+		TypewriterDelay:   10 * time.Millisecond,
+		AvoidRecent:       10,
+		SimilarityWeights: defaultSimilarityWeights,
+	}
+	config.applyDifficulty("normal") // Eight guesses, three hints, six minutes
+	return config
+}
+
+// parseFlags reads command-line arguments into a GameConfig, starting from the defaults
+func parseFlags(args []string) (GameConfig, error) {
+	return parseFlagsTo(args, os.Stderr)
+}
+
+// parseFlagsTo is parseFlags with problems and usage printed to the given writer
+func parseFlagsTo(args []string, output io.Writer) (GameConfig, error) {
+	config := defaultConfig()
+
+	// Use a dedicated flag set so parsing errors are returned instead of exiting
+	fs := flag.NewFlagSet("hoop-detective", flag.ContinueOnError)
+	fs.SetOutput(output)
+	fs.BoolVar(&config.Resume, "resume", false, "Resume the game saved when you last typed 'quit'")
+	fs.StringVar(&config.SaveFile, "save-file", config.SaveFile, "File used to save and resume games")
+	fs.BoolVar(&config.Quiet, "quiet", false, "Turn off encouragement and taunt messages")
+	fs.BoolVar(&config.Verbose, "verbose", false, "Print detailed loading and debug output")
+	fs.BoolVar(&config.Strict, "strict", false, "Exit with an error instead of using the legends-only list when no API key is set")
+	fs.BoolVar(&config.AllowRepeats, "allow-repeats", false, "Don't ask for confirmation when guessing a player twice")
+	fs.StringVar(&config.Theme, "theme", config.Theme, "Marker theme: "+strings.Join(themeNames(), ", "))
+	fs.BoolVar(&config.NoReplayPrompt, "no-replay-prompt", false, "Exit after one game instead of asking to play again")
+	fs.BoolVar(&config.NoSpoil, "no-spoil", false, "Don't reveal the answer after a loss until you type 'reveal'")
+	fs.Var(&config.PlayersFiles, "players-file", "JSON file of players to use instead of the API (repeat to merge several files)")
+	fs.Var(&config.PlayersCSVFiles, "players-csv", "CSV file of players with a header row to use instead of the API (repeatable)")
+	fs.BoolVar(&config.Daily, "daily", false, "Play today's daily challenge (same mystery player for everyone, once per day)")
+	fs.StringVar(&config.TargetName, "target-name", "", "Debugging: make this exact player the mystery player, e.g. \"Nikola Jokić\"")
+	fs.BoolVar(&config.Weekly, "weekly", false, "Play this week's challenge: seven mystery players, one unlocked each day (UTC)")
+	fs.BoolVar(&config.Zen, "zen", false, "No timer and unlimited attempts")
+	fs.StringVar(&config.StatsFile, "stats-file", config.StatsFile, "File used to record daily challenge results")
+	fs.IntVar(&config.AvoidRecent, "avoid-recent", config.AvoidRecent, fmt.Sprintf("Don't pick any of the last N mystery players again (0-%d, 0 allows repeats)", maxRecentTargets))
+	fs.StringVar(&config.H2HName, "h2h-name", "", "Record your daily and weekly results under this name for head-to-head comparison")
+	fs.StringVar(&config.H2HFile, "h2h-file", config.H2HFile, "File shared by everyone in a head-to-head")
+	fs.BoolVar(&config.H2HReport, "h2h", false, "Show who won more of the daily and weekly puzzles you both played, then exit")
+	fs.BoolVar(&config.TrackPlayers, "track-players", false, "Record who you guess and who the mystery player was in the stats file (off by default)")
+	fs.BoolVar(&config.Analytics, "analytics", false, "Show the most-guessed players and the win rate per mystery player from the stats file, then exit")
+	fs.StringVar(&config.TeamMode, "team-mode", config.TeamMode, "Team compared in clues: current, or iconic (the team a star is best known for)")
+	fs.BoolVar(&config.Compact, "compact", false, "Show each guess as one short line of labeled markers")
+	fs.StringVar(&config.CompactOrder, "compact-order", config.CompactOrder, "Attribute order of -compact lines: priority (most informative first, by the similarity weights) or fixed (the table's column order)")
+	fs.BoolVar(&config.ExcludeUnknown, "exclude-unknown", false, "Remove players with an unknown position from the game entirely")
+	fs.BoolVar(&config.StarterClue, "starter-clue", false, "Reveal one weak clue (country, position, or draft tier) for free at the start of each game")
+	fs.StringVar(&config.ReplayFile, "save-replay", "", "Write each finished game to this replay file for sharing (player mode)")
+	fs.StringVar(&config.PlayReplay, "play-replay", "", "Watch the game in this replay file guess by guess, then exit")
+	fs.StringVar(&config.CSVFile, "csv", "", "Export every guess of the session with per-attribute results to this CSV file")
+	fs.IntVar(&config.PerPage, "per-page", config.PerPage, fmt.Sprintf("Players requested per API page (clamped to 1-%d)", maxPerPage))
+	fs.BoolVar(&config.Historical, "historical", false, "Load every player in NBA history from the API instead of only active players")
+	fs.DurationVar(&config.ProbeTimeout, "probe-timeout", config.ProbeTimeout, "How long to wait for the NBA API to answer before starting offline with the built-in players (0 skips the check)")
+	fs.IntVar(&config.MaxPages, "max-pages", config.MaxPages, fmt.Sprintf("Number of pages to fetch from the API (1-%d)", maxPagesLimit))
+	fs.StringVar(&config.Mode, "mode", config.Mode, "Game mode: player, attributes (guess one attribute at a time, e.g. 'position: C'), or team (guess the mystery NBA team)")
+	fs.BoolVar(&config.TimeSplits, "time-splits", false, "Show how long each guess took and the average time per guess")
+	fs.IntVar(&config.DraftClass, "draft-class", 0, "Only pick the mystery player from this draft year, e.g. 2003")
+	fs.BoolVar(&config.DraftClassOnly, "draft-class-only", false, "With -draft-class, only players from that draft can be guessed too")
+	fs.BoolVar(&config.Blind, "blind", false, "Show only the match markers for each guess, not the guessed player's values")
+	fs.StringVar(&config.RevealOrder, "reveal-order", config.RevealOrder, "Order of the answer card: standard, or suspense (weakest clue first, following -hint-ladder, with the team and name last)")
+	fs.StringVar(&config.SmallPool, "small-pool", config.SmallPool, "When no more players can be guessed than there are attempts: adjust (lower attempts to the pool size minus one), warn, or off")
+	fs.StringVar(&config.Format, "format", "", "End-of-game summary: json prints a one-line JSON result after each game, text never does (default: json only when input is scripted)")
+	fs.StringVar(&config.Separator, "separator", config.Separator, "Table column separator: "+strings.Join(separatorNames(), ", "))
+	fs.BoolVar(&config.Border, "border", false, "Draw a box border around the comparison table and between its rows")
+	fs.DurationVar(&config.Endurance, "endurance", 0, "Solve as many mystery players as you can within this total time, e.g. 15m")
+	fs.DurationVar(&config.SessionTime, "session-time", 0, "Stop offering new games once this much time has passed in the session, e.g. 30m (a game in progress is finished)")
+	fs.DurationVar(&config.TimeTrade, "time-trade", 0, fmt.Sprintf("Let the 'time' command trade an attempt for this much extra time, up to %d times a game (e.g. 60s)", maxTimeTrades))
+	fs.DurationVar(&config.Pace, "pace", 0, "Require a guess at least this often (e.g. 30s) or lose a hint, then attempts")
+	fs.BoolVar(&config.Async, "async", false, "Casual play over days: no timer, no spoilers, and the game is saved instead of revealed if a clock runs out")
+	fs.BoolVar(&config.Legend, "legend", false, "Explain the markers and every table column (with the current tolerances) before playing")
+	fs.BoolVar(&config.Quiz, "quiz", false, fmt.Sprintf("After the answer is revealed, ask a multiple-choice question about the mystery player for %d bonus point", quizPoints))
+	fs.IntVar(&config.HistoryLimit, "history-limit", config.HistoryLimit, "Show at most this many of the latest guesses when the board is reprinted, e.g. on -resume (0 shows all)")
+	fs.BoolVar(&config.TeamArt, "team-art", false, "Give the team hint as an ASCII silhouette of the team's logo, a softer clue than its name (plain text for teams without art)")
+	fs.StringVar(&config.PickGap, "pick-gap", config.PickGap, "Show how far a close draft pick is from the mystery pick: off, number (+3), arrow (↑ for a later pick), or bucket (≤5)")
+	fs.BoolVar(&config.StrictPositions, "strict-positions", false, "Don't treat a generic guard or forward (G, F) as close to PG/SG or SF/PF")
+	fs.BoolVar(&config.Heat, "heat", false, "After each guess, show how close it is overall as a heat percentage")
+	fs.BoolVar(&config.Delta, "delta", false, "After each guess, show which markers changed since the previous guess")
+	fs.BoolVar(&config.Assist, "assist", false, "After each guess, show which values of each attribute are still possible")
+	fs.BoolVar(&config.ShowRemaining, "show-remaining", false, "After each guess, show how many players are still consistent with every clue")
+	fs.StringVar(&config.Durations, "durations", config.Durations, "How durations are written in messages and summaries: verbose (3 minutes 12 seconds) or compact (3m12s)")
+	fs.StringVar(&config.Locale, "locale", config.Locale, "Language for durations and number formatting: "+strings.Join(localeNames(), ", "))
+	fs.IntVar(&config.FuzzyDistance, "fuzzy-distance", config.FuzzyDistance, "Accept misspelled names within this many typos (0 turns typo correction off)")
+	fs.BoolVar(&config.NoFuzzy, "no-fuzzy", false, "Only accept exact names and nicknames - no partial names or typo correction")
+	fs.BoolVar(&config.Hardcore, "hardcore", false, "Names that aren't found cost an attempt, and there are no automatic name hints")
+	fs.BoolVar(&config.NoTransliteration, "no-transliteration", false, "Don't accept common phonetic spellings of international names (e.g. Yokic for Jokic)")
+	fs.BoolVar(&config.ExactNames, "exact-names", false, "Only accept full player names (ignoring case and accents) - no nicknames, partial names, or typos")
+	fs.BoolVar(&config.AnimateCells, "animate", false, "Reveal each guess's cells one at a time, left to right (terminal only)")
+	fs.BoolVar(&config.Typewriter, "typewriter", false, "Type out the final answer card one character at a time (terminal only)")
+	fs.DurationVar(&config.TypewriterDelay, "typewriter-delay", config.TypewriterDelay, "Pause after each character with -typewriter (at most 50ms)")
+	fs.BoolVar(&config.NoMenu, "no-menu", false, "Skip the settings menu shown when the game starts without flags")
+	fs.BoolVar(&config.IncludeTwoWay, "include-twoway", false, "Allow players on two-way contracts to be the mystery player")
+	fs.StringVar(&config.HintOrder, "hint-order", config.HintOrder, "How hints are chosen: random, or ladder (weakest to strongest, see -hint-ladder)")
+	fs.IntVar(&config.HintMinShared, "hint-min-shared", 0, "Skip hints whose value fewer than this many players share, so one hint can't give the answer away (0 turns the guard off)")
+	hintLadder := fs.String("hint-ladder", strings.Join(defaultHintLadder, ","), "Comma-separated attributes revealed in order by -hint-order=ladder")
+	candidatesFile := fs.String("candidates-file", "", "JSON array of player names; the mystery player is one of them, and only they can be guessed")
+	var excludeValues, excludeFiles stringList
+	fs.Var(&excludeValues, "exclude", "Comma-separated players who are never the mystery player but can still be guessed (repeatable)")
+	fs.Var(&excludeFiles, "exclude-file", "File of players (one per line) who are never the mystery player (repeatable)")
+	difficulty := fs.String("difficulty", "normal", "Difficulty preset for limits and closeness tolerances: easy, normal, or hard")
+
+	configPath := fs.String("config", defaultConfigFile(), "JSON file of default settings; flags override it")
+
+	if err := fs.Parse(args); err != nil {
+		return config, err
+	}
+
+	// fail reports a problem with the settings; the flag package prints its own parse errors
+	fail := func(err error) (GameConfig, error) {
+		fmt.Fprintln(fs.Output(), err)
+		return config, err
+	}
+
+	// Settings come from the defaults, then the config file, then the flags actually given
+	flagsSet := make(map[string]bool)
+	fs.Visit(func(f *flag.Flag) { flagsSet[f.Name] = true })
+	file, err := loadConfigFile(*configPath, flagsSet["config"])
+	if err != nil {
+		return fail(err)
+	}
+	difficultyName := *difficulty
+	if file != nil && file.Difficulty != nil && !flagsSet["difficulty"] {
+		difficultyName = *file.Difficulty
+	}
+	if err := config.applyDifficulty(difficultyName); err != nil {
+		return fail(err)
+	}
+	if file != nil {
+		if err := file.apply(&config, flagsSet); err != nil {
+			return fail(err)
+		}
+	}
+
+	// Lists of players and attributes are read before the settings are checked as a whole
+	if config.ExcludedTargets, err = excludedTargetNames(excludeValues, excludeFiles); err != nil {
+		return fail(err)
+	}
+	if *candidatesFile != "" {
+		if config.Candidates, err = loadCandidatesFile(*candidatesFile); err != nil {
+			return fail(err)
+		}
+	}
+	if config.HintLadder, err = parseHintLadder(*hintLadder); err != nil {
+		return fail(err)
+	}
+
+	if err := config.validate(); err != nil {
+		return fail(err)
+	}
+	config.applyModes()
+	return config, nil
+}
+
+// validate checks that every setting has a known value and that the chosen modes can be combined
+func (c GameConfig) validate() error {
+	choices := []struct {
+		value, what string
+		allowed     []string
+	}{
+		{c.TeamMode, "team mode", []string{"current", "iconic"}},
+		{c.Durations, "duration style", []string{durationVerbose, durationCompact}},
+		{c.RevealOrder, "reveal order", []string{"standard", "suspense"}},
+		{c.PickGap, "pick gap style", []string{"off", "number", "arrow", "bucket"}},
+		{c.CompactOrder, "compact order", []string{"priority", "fixed"}},
+		{c.SmallPool, "small-pool behavior", []string{"adjust", "warn", "off"}},
+		{c.Separator, "separator", separatorNames()},
+		{c.HintOrder, "hint order", []string{"random", "ladder"}},
+		{c.Mode, "mode", []string{"player", "attributes", "team"}},
+	}
+	for _, choice := range choices {
+		if !slices.Contains(choice.allowed, choice.value) {
+			return fmt.Errorf("unknown %s %q (choose %s)", choice.what, choice.value, joinChoices(choice.allowed))
+		}
+	}
+	if _, found := locales[strings.ToLower(c.Locale)]; !found {
+		return fmt.Errorf("unknown locale %q (choose %s)", c.Locale, strings.Join(localeNames(), ", "))
+	}
+	if c.Format != "" && c.Format != "json" && c.Format != "text" {
+		return fmt.Errorf("unknown format %q (choose json or text)", c.Format)
+	}
+
+	// Counts and durations that only make sense from zero up
+	nonNegative := []struct {
+		flag     string
+		negative bool
+		value    any
+	}{
+		{"-hint-min-shared", c.HintMinShared < 0, c.HintMinShared},
+		{"-fuzzy-distance", c.FuzzyDistance < 0, c.FuzzyDistance},
+		{"-time-trade", c.TimeTrade < 0, c.TimeTrade},
+		{"-pace", c.Pace < 0, c.Pace},
+		{"-history-limit", c.HistoryLimit < 0, c.HistoryLimit},
+		{"-probe-timeout", c.ProbeTimeout < 0, c.ProbeTimeout},
+		{"-endurance", c.Endurance < 0, c.Endurance},
+		{"-session-time", c.SessionTime < 0, c.SessionTime},
+	}
+	for _, setting := range nonNegative {
+		if setting.negative {
+			return fmt.Errorf("%s must not be negative, got %v", setting.flag, setting.value)
+		}
+	}
+
+	switch {
+	case c.DraftClass != 0 && !isValidDraftYear(c.DraftClass):
+		return fmt.Errorf("-draft-class must be a draft year from %d to this year, got %d", firstDraftYear, c.DraftClass)
+	case c.DraftClassOnly && c.DraftClass == 0:
+		return fmt.Errorf("-draft-class-only needs -draft-class")
+	case c.MaxPages < 1 || c.MaxPages > maxPagesLimit:
+		return fmt.Errorf("-max-pages must be between 1 and %d, got %d", maxPagesLimit, c.MaxPages)
+	case c.AvoidRecent < 0 || c.AvoidRecent > maxRecentTargets:
+		return fmt.Errorf("-avoid-recent must be between 0 and %d, got %d", maxRecentTargets, c.AvoidRecent)
+	case c.TargetName != "" && (c.Daily || c.Weekly || c.Resume):
+		return fmt.Errorf("-target-name can't be combined with -daily, -weekly, or -resume")
+	case c.Daily && c.Weekly:
+		return fmt.Errorf("-daily and -weekly can't be combined")
+	case c.Hardcore && c.Zen:
+		// Hardcore is about running out of attempts, which zen would make impossible
+		return fmt.Errorf("-hardcore can't be combined with -zen")
+	case c.Endurance > 0 && (c.Zen || c.Daily || c.Weekly || c.Resume):
+		return fmt.Errorf("-endurance can't be combined with -zen, -daily, -weekly, or -resume")
+	case c.SessionTime > 0 && (c.NoReplayPrompt || c.Endurance > 0):
+		// The session cap ends the play-again loop, so it needs a loop to end
+		return fmt.Errorf("-session-time can't be combined with -no-replay-prompt or -endurance")
+	case c.Async && (c.Pace > 0 || c.Endurance > 0):
+		// Async games are suspended and resumed at leisure, so nothing may run on a clock
+		return fmt.Errorf("-async can't be combined with -pace or -endurance")
+	}
+	return nil
+}
+
+// applyModes derives the settings that modes imply once the config is valid
+func (c *GameConfig) applyModes() {
+	c.PerPage = max(1, min(maxPerPage, c.PerPage)) // Out-of-range sizes are clamped, not rejected
+	if c.Hardcore {
+		c.NameHints = nil
+	}
+	// Endurance replaces the per-game time limit with one budget for the whole session
+	if c.Endurance > 0 {
+		c.TimeLimit = c.Endurance
+	}
+	if c.Async {
+		c.NoTimeLimit = true
+		c.NoSpoil = true // A loss waits for 'reveal', so nobody sharing the game is spoiled
+	}
+	// Zen removes both limits; each stays independently controllable in GameConfig
+	if c.Zen {
+		c.NoTimeLimit = true
+		c.UnlimitedAttempts = true
+	}
+}
+
+// joinChoices lists choices for an error message, e.g. "a, b, or c" or "a or b"
+func joinChoices(choices []string) string {
+	switch len(choices) {
+	case 0:
+		return ""
+	case 1:
+		return choices[0]
+	case 2:
+		return choices[0] + " or " + choices[1]
+	}
+	return strings.Join(choices[:len(choices)-1], ", ") + ", or " + choices[len(choices)-1]
+}
+
+// theme returns the configured marker theme, or the default theme if the name is unknown
+func (c GameConfig) theme() Theme {
+	if theme, found := getTheme(c.Theme); found {
+		return theme
+	}
+	return themes["default"]
+}
+
+// rng returns the session's random source, or a fresh time-seeded one for a config that
+// wasn't built by defaultConfig
+func (c GameConfig) rng() *rand.Rand {
+	if c.Rand == nil {
+		return rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+	return c.Rand
+}
+
+// clock returns the session's clock, or the wall clock for a config that doesn't set one
+func (c GameConfig) clock() Clock {
+	if c.Clock == nil {
+		return systemClock{}
+	}
+	return c.Clock
+}
+
+// limitsDescription describes the attempt and time limits, e.g. "8 attempts and 6m 0s"
+func (c GameConfig) limitsDescription() string {
+	attempts := fmt.Sprintf("%d %s", c.MaxAttempts, unit(c.MaxAttempts, "attempt", "attempts")) // Small pools can leave a single attempt
+	if c.UnlimitedAttempts {
+		attempts = "unlimited attempts"
+	}
+	if c.NoTimeLimit {
+		return attempts + " and no time limit"
+	}
+	return attempts + " and " + formatTimeRemaining(c.TimeLimit)
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"io"        // Package for discarding flag error output
+	"math/rand" // Package for seeded random sources
+	"slices"    // Package for comparing choice lists
+	"strings"   // Package for string manipulation functions
+	"testing"   // Package for Go tests
+)
+
+// parseTestFlags parses flags with a home directory of its own, so no real config file is read
+func parseTestFlags(t *testing.T, args ...string) (GameConfig, error) {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+	return parseFlagsTo(args, io.Discard)
+}
+
+// TestValidate checks that validate accepts the defaults and rejects each bad value or combination
+func TestValidate(t *testing.T) {
+	if err := defaultConfig().validate(); err != nil {
+		t.Fatalf("defaults are invalid: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		change func(*GameConfig)
+		want   string
+	}{
+		{"unknown mode", func(c *GameConfig) { c.Mode = "tag" }, `unknown mode "tag" (choose player, attributes, or team)`},
+		{"unknown separator", func(c *GameConfig) { c.Separator = "dots" }, `unknown separator "dots" (choose bars or spaces)`},
+		{"unknown locale", func(c *GameConfig) { c.Locale = "xx" }, `unknown locale "xx"`},
+		{"unknown format", func(c *GameConfig) { c.Format = "xml" }, `unknown format "xml"`},
+		{"negative pace", func(c *GameConfig) { c.Pace = -1 }, "-pace must not be negative"},
+		{"negative history limit", func(c *GameConfig) { c.HistoryLimit = -1 }, "-history-limit must not be negative, got -1"},
+		{"max pages out of range", func(c *GameConfig) { c.MaxPages = 0 }, "-max-pages must be between 1 and"},
+		{"draft class only without class", func(c *GameConfig) { c.DraftClassOnly = true }, "-draft-class-only needs -draft-class"},
+		{"hardcore zen", func(c *GameConfig) { c.Hardcore, c.Zen = true, true }, "-hardcore can't be combined with -zen"},
+		{"endurance daily", func(c *GameConfig) { c.Endurance, c.Daily = 1, true }, "-endurance can't be combined"},
+		{"session without replays", func(c *GameConfig) { c.SessionTime, c.NoReplayPrompt = 1, true }, "-session-time can't be combined"},
+		{"async pace", func(c *GameConfig) { c.Async, c.Pace = true, 1 }, "-async can't be combined"},
+		{"daily weekly", func(c *GameConfig) { c.Daily, c.Weekly = true, true }, "-daily and -weekly can't be combined"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := defaultConfig()
+			test.change(&config)
+			err := config.validate()
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("validate() = %v, want an error containing %q", err, test.want)
+			}
+		})
+	}
+}
+
+// TestParseFlagsApplyModes checks the settings modes imply once parsing succeeds
+func TestParseFlagsApplyModes(t *testing.T) {
+	config, err := parseTestFlags(t, "-zen", "-per-page=500")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !config.NoTimeLimit || !config.UnlimitedAttempts {
+		t.Errorf("-zen left limits on: NoTimeLimit=%v UnlimitedAttempts=%v", config.NoTimeLimit, config.UnlimitedAttempts)
+	}
+	if config.PerPage != maxPerPage {
+		t.Errorf("-per-page=500 gave %d, want it clamped to %d", config.PerPage, maxPerPage)
+	}
+
+	config, err = parseTestFlags(t, "-async")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !config.NoTimeLimit || !config.NoSpoil {
+		t.Errorf("-async should turn on NoTimeLimit and NoSpoil, got %v and %v", config.NoTimeLimit, config.NoSpoil)
+	}
+
+	if _, err := parseTestFlags(t, "-zen", "-hardcore"); err == nil {
+		t.Error("-zen -hardcore was accepted")
+	}
+}
+
+// TestJoinChoices checks the lists used in error messages
+func TestJoinChoices(t *testing.T) {
+	tests := map[string][]string{
+		"a":          {"a"},
+		"a or b":     {"a", "b"},
+		"a, b, or c": {"a", "b", "c"},
+	}
+	for want, choices := range tests {
+		if got := joinChoices(choices); got != want {
+			t.Errorf("joinChoices(%q) = %q, want %q", choices, got, want)
+		}
+	}
+}
+
+// seededChoices plays out every kind of random decision on one config's random source: mystery
+// players, hints, feedback messages, and quiz questions, in a fixed order
+func seededChoices(t *testing.T, seed int64) []string {
+	t.Helper()
+	config := defaultConfig()
+	config.Rand = rand.New(rand.NewSource(seed))
+	config.AvoidRecent = 0
+
+	var choices []string
+	for i := 0; i < 5; i++ {
+		target, err := getRandomPlayer(config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		choices = append(choices, "target "+target.Name)
+
+		game := newGame(config, target)
+		for hint := 1; hint <= 3; hint++ {
+			attribute, _ := pickUnusedAttribute(hintAttributes, game.UsedHintAttributes, config.rng())
+			choices = append(choices, "hint "+attribute)
+		}
+		game.recordGuess(testGuess(game))
+		choices = append(choices, "message "+guessFeedbackMessage(game.History, config.rng()))
+		if question, found := newQuizQuestion(target, config, config.rng()); found {
+			choices = append(choices, "quiz "+question.Prompt+" "+strings.Join(question.Choices, "/"))
+		}
+	}
+	return choices
+}
+
+// TestSameSeedSameChoices checks that two sessions with the same random source make identical
+// choices throughout, so any game can be reproduced from its seed
+func TestSameSeedSameChoices(t *testing.T) {
+	useFallbackPlayers(t)
+	first, second := seededChoices(t, 42), seededChoices(t, 42)
+	if !slices.Equal(first, second) {
+		t.Errorf("the same seed made different choices:\n%v\n%v", first, second)
+	}
+	if other := seededChoices(t, 43); slices.Equal(first, other) {
+		t.Error("different seeds made identical choices")
+	}
+}
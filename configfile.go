@@ -0,0 +1,162 @@
+package main
+
+import (
+	"bytes"         // Package for counting lines before a syntax error
+	"encoding/json" // Package for JSON encoding and decoding
+	"errors"        // Package for inspecting JSON errors
+	"fmt"           // Package for formatted I/O operations
+	"os"            // Package for file operations
+	"path/filepath" // Package for building file paths
+	"time"          // Package for time-related operations
+)
+
+// ConfigFile holds the settings that can be stored in config.json
+// Every field is a pointer so settings left out of the file keep their defaults
+type ConfigFile struct {
+	Difficulty            *string `json:"difficulty"`              // easy, normal, or hard
+	Mode                  *string `json:"mode"`                    // player, attributes, or team
+	Theme                 *string `json:"theme"`                   // Marker theme
+	Locale                *string `json:"locale"`                  // Language for durations and numbers
+	TeamMode              *string `json:"team_mode"`               // current or iconic
+	HintOrder             *string `json:"hint_order"`              // random or ladder
+	MaxAttempts           *int    `json:"max_attempts"`            // Replaces the difficulty's attempt limit
+	MaxHints              *int    `json:"max_hints"`               // Replaces the difficulty's hint limit
+	TimeLimit             *string `json:"time_limit"`              // Replaces the difficulty's time limit, e.g. "5m"
+	DraftYearTolerance    *int    `json:"draft_year_tolerance"`    // Replaces the difficulty's draft year tolerance
+	DraftPickTolerance    *int    `json:"draft_pick_tolerance"`    // Replaces the difficulty's draft pick tolerance
+	HeightToleranceInches *int    `json:"height_tolerance_inches"` // Replaces the difficulty's height tolerance
+	WeightToleranceLbs    *int    `json:"weight_tolerance_lbs"`    // Replaces the difficulty's weight tolerance
+	FuzzyDistance         *int    `json:"fuzzy_distance"`          // Typos forgiven in names
+	MaxPages              *int    `json:"max_pages"`               // API pages to fetch
+	PerPage               *int    `json:"per_page"`                // Players requested per API page
+	Historical            *bool   `json:"historical"`              // Load every player in NBA history
+	Quiet                 *bool   `json:"quiet"`                   // Turn off encouragement and taunts
+	Compact               *bool   `json:"compact"`                 // One line per guess
+	CompactOrder          *string `json:"compact_order"`           // Attribute order of compact lines: priority or fixed
+	Blind                 *bool   `json:"blind"`                   // Markers only
+	Separator             *string `json:"separator"`               // Table column separator: bars or spaces
+	Border                *bool   `json:"border"`                  // Box the comparison table
+	Assist                *bool   `json:"assist"`                  // Show the still-possible values after each guess
+	StatsFile             *string `json:"stats_file"`              // Where stats are recorded
+	SaveFile              *string `json:"save_file"`               // Where games are saved
+}
+
+// defaultConfigFile returns ~/.hoop-detective/config.json, or a local file if the home directory is unknown
+func defaultConfigFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ".hoop-detective-config.json"
+	}
+	return filepath.Join(home, ".hoop-detective", "config.json")
+}
+
+// loadConfigFile reads a config file; a missing file is only an error when it was asked for with -config
+func loadConfigFile(path string, required bool) (*ConfigFile, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) && !required {
+		return nil, nil // No config file - the defaults apply
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %v", err)
+	}
+
+	file := &ConfigFile{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields() // A misspelled setting would otherwise be silently ignored
+	if err := decoder.Decode(file); err != nil {
+		var syntaxErr *json.SyntaxError
+		var typeErr *json.UnmarshalTypeError
+		switch {
+		case errors.As(err, &syntaxErr):
+			return nil, fmt.Errorf("config file %s line %d: %v", path, lineOf(data, syntaxErr.Offset), err)
+		case errors.As(err, &typeErr):
+			return nil, fmt.Errorf("config file %s line %d: %q can't be a JSON %s", path, lineOf(data, typeErr.Offset), typeErr.Field, typeErr.Value)
+		}
+		return nil, fmt.Errorf("config file %s: %v", path, err)
+	}
+	return file, nil
+}
+
+// lineOf returns the 1-based line containing the given byte offset
+func lineOf(data []byte, offset int64) int {
+	offset = min(offset, int64(len(data)))
+	return bytes.Count(data[:offset], []byte("\n")) + 1
+}
+
+// apply copies every setting in the file into the config, skipping settings whose flag was
+// given on the command line, since flags take precedence over the file
+// The difficulty is applied by the caller before this, so the file's limits and tolerances replace the
+// preset - unless -difficulty was given, which then decides them
+func (f *ConfigFile) apply(config *GameConfig, flagsSet map[string]bool) error {
+	setString := func(flagName string, dst *string, value *string) {
+		if value != nil && !flagsSet[flagName] {
+			*dst = *value
+		}
+	}
+	setInt := func(flagName string, dst *int, value *int) {
+		if value != nil && !flagsSet[flagName] {
+			*dst = *value
+		}
+	}
+	setBool := func(flagName string, dst *bool, value *bool) {
+		if value != nil && !flagsSet[flagName] {
+			*dst = *value
+		}
+	}
+
+	setString("mode", &config.Mode, f.Mode)
+	setString("theme", &config.Theme, f.Theme)
+	setString("locale", &config.Locale, f.Locale)
+	setString("team-mode", &config.TeamMode, f.TeamMode)
+	setString("hint-order", &config.HintOrder, f.HintOrder)
+	setString("stats-file", &config.StatsFile, f.StatsFile)
+	setString("save-file", &config.SaveFile, f.SaveFile)
+	setString("separator", &config.Separator, f.Separator)
+	setString("compact-order", &config.CompactOrder, f.CompactOrder)
+	setInt("fuzzy-distance", &config.FuzzyDistance, f.FuzzyDistance)
+	setInt("max-pages", &config.MaxPages, f.MaxPages)
+	setInt("per-page", &config.PerPage, f.PerPage)
+	setBool("historical", &config.Historical, f.Historical)
+	setBool("quiet", &config.Quiet, f.Quiet)
+	setBool("compact", &config.Compact, f.Compact)
+	setBool("blind", &config.Blind, f.Blind)
+	setBool("border", &config.Border, f.Border)
+	setBool("assist", &config.Assist, f.Assist)
+
+	// Limits and tolerances have no flags of their own; they fine-tune the difficulty preset
+	if flagsSet["difficulty"] {
+		return nil
+	}
+	limits := []struct {
+		name  string
+		dst   *int
+		value *int
+	}{
+		{"max_attempts", &config.MaxAttempts, f.MaxAttempts},
+		{"max_hints", &config.MaxHints, f.MaxHints},
+		{"draft_year_tolerance", &config.DraftYearTolerance, f.DraftYearTolerance},
+		{"draft_pick_tolerance", &config.DraftPickTolerance, f.DraftPickTolerance},
+		{"height_tolerance_inches", &config.HeightToleranceInches, f.HeightToleranceInches},
+		{"weight_tolerance_lbs", &config.WeightToleranceLbs, f.WeightToleranceLbs},
+	}
+	for _, limit := range limits {
+		if limit.value == nil {
+			continue
+		}
+		if *limit.value < 0 {
+			return fmt.Errorf("config file: %s must not be negative, got %d", limit.name, *limit.value)
+		}
+		*limit.dst = *limit.value
+	}
+	if f.MaxAttempts != nil && *f.MaxAttempts == 0 {
+		return fmt.Errorf("config file: max_attempts must be at least 1")
+	}
+	if f.TimeLimit != nil {
+		limit, err := time.ParseDuration(*f.TimeLimit)
+		if err != nil || limit <= 0 {
+			return fmt.Errorf("config file: time_limit must be a positive duration like \"5m\" or \"90s\", got %q", *f.TimeLimit)
+		}
+		config.TimeLimit = limit
+	}
+	return nil
+}
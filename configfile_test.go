@@ -0,0 +1,124 @@
+package main
+
+import (
+	"io"            // Package for discarding flag error output
+	"os"            // Package for file operations
+	"path/filepath" // Package for building file paths
+	"strings"       // Package for string manipulation functions
+	"testing"       // Package for Go tests
+	"time"          // Package for time-related operations
+)
+
+// TestConfigFileDefaults checks that without a config file the defaults and the normal
+// difficulty apply, and that the file in the home directory is read when present
+func TestConfigFileDefaults(t *testing.T) {
+	config, err := parseTestFlags(t)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defaults, normal := defaultConfig(), difficultyPresets["normal"]
+	if config.Theme != defaults.Theme || config.Mode != defaults.Mode || config.Quiet != defaults.Quiet {
+		t.Errorf("without a config file: theme %q, mode %q, quiet %v; want the defaults", config.Theme, config.Mode, config.Quiet)
+	}
+	if config.MaxAttempts != normal.MaxAttempts || config.TimeLimit != normal.TimeLimit {
+		t.Errorf("without a config file: %d attempts, %v; want the normal preset", config.MaxAttempts, config.TimeLimit)
+	}
+
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	if err := os.MkdirAll(filepath.Join(home, ".hoop-detective"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(home, ".hoop-detective", "config.json"), []byte(`{"theme": "squares"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	config, err = parseFlagsTo(nil, io.Discard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Theme != "squares" {
+		t.Errorf("the config file in the home directory gave theme %q, want squares", config.Theme)
+	}
+}
+
+// TestConfigFileLoads checks that each kind of setting in a config file reaches the config
+func TestConfigFileLoads(t *testing.T) {
+	path := writeTestFile(t, "config.json", `{
+		"difficulty": "hard",
+		"theme": "basketball",
+		"mode": "attributes",
+		"quiet": true,
+		"fuzzy_distance": 1,
+		"max_hints": 4,
+		"time_limit": "90s"
+	}`)
+	config, err := parseTestFlags(t, "-config", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hard := difficultyPresets["hard"]
+	if config.Difficulty != "hard" || config.MaxAttempts != hard.MaxAttempts {
+		t.Errorf("difficulty %q with %d attempts, want hard with %d", config.Difficulty, config.MaxAttempts, hard.MaxAttempts)
+	}
+	if config.Theme != "basketball" || config.Mode != "attributes" || !config.Quiet || config.FuzzyDistance != 1 {
+		t.Errorf("theme %q, mode %q, quiet %v, fuzzy distance %d; want the file's settings", config.Theme, config.Mode, config.Quiet, config.FuzzyDistance)
+	}
+	if config.MaxHints != 4 || config.TimeLimit != 90*time.Second {
+		t.Errorf("%d hints, %v; want the file's 4 and 90s to replace the preset", config.MaxHints, config.TimeLimit)
+	}
+}
+
+// TestConfigFilePrecedence checks that flags given on the command line beat the config file,
+// which beats the defaults, and that -difficulty decides the limits outright
+func TestConfigFilePrecedence(t *testing.T) {
+	path := writeTestFile(t, "config.json", `{"difficulty": "hard", "theme": "basketball", "quiet": true, "max_attempts": 12}`)
+
+	config, err := parseTestFlags(t, "-config", path, "-theme", "squares", "-quiet=false")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Theme != "squares" || config.Quiet {
+		t.Errorf("theme %q, quiet %v; want the flags' squares and false", config.Theme, config.Quiet)
+	}
+	if config.MaxAttempts != 12 {
+		t.Errorf("%d attempts, want the file's 12 over the hard preset", config.MaxAttempts)
+	}
+
+	config, err = parseTestFlags(t, "-config", path, "-difficulty", "easy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	easy := difficultyPresets["easy"]
+	if config.Difficulty != "easy" || config.MaxAttempts != easy.MaxAttempts {
+		t.Errorf("difficulty %q with %d attempts, want -difficulty easy's %d", config.Difficulty, config.MaxAttempts, easy.MaxAttempts)
+	}
+	if config.Theme != "basketball" {
+		t.Errorf("theme %q, want the file's basketball where no flag was given", config.Theme)
+	}
+}
+
+// TestConfigFileErrors checks that a bad config file stops the game with a clear message
+func TestConfigFileErrors(t *testing.T) {
+	tests := []struct {
+		name, content, want string
+	}{
+		{"unknown setting", `{"thme": "squares"}`, `unknown field "thme"`},
+		{"syntax error", "{\n\"theme\": \"squares\",\n}", "line 3"},
+		{"wrong type", `{"quiet": "yes"}`, `"quiet" can't be a JSON string`},
+		{"negative limit", `{"max_hints": -1}`, "max_hints must not be negative"},
+		{"zero attempts", `{"max_attempts": 0}`, "max_attempts must be at least 1"},
+		{"bad time limit", `{"time_limit": "soon"}`, "time_limit must be a positive duration"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := parseTestFlags(t, "-config", writeTestFile(t, "config.json", test.content))
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("parse = %v, want an error containing %q", err, test.want)
+			}
+		})
+	}
+
+	if _, err := parseTestFlags(t, "-config", filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("a missing file given with -config was accepted")
+	}
+}
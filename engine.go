@@ -0,0 +1,437 @@
+package main
+
+import (
+	"fmt"     // Package for formatted I/O operations
+	"math"    // Package for numeric limits
+	"os"      // Package for checking whether output is a terminal
+	"strings" // Package for string manipulation functions
+	"time"    // Package for time-related operations
+)
+
+// GameOutcome describes how a game ended
+type GameOutcome int
+
+const (
+	OutcomeWon    GameOutcome = iota // The mystery player was guessed
+	OutcomeLost                      // All attempts were used
+	OutcomeTimeUp                    // The time limit expired
+	OutcomeQuit                      // The player quit (and the game was saved)
+)
+
+// GuessRecord stores a single valid guess and the feedback it produced
+type GuessRecord struct {
+	Player Player           // The player that was guessed
+	Result ComparisonResult // Color-coded comparison against the target
+	At     time.Time        // When the guess was made
+}
+
+// Game holds the complete state of one game so it can be played, saved, and resumed
+type Game struct {
+	Config             GameConfig        // Settings the game was started with
+	Target             Player            // The mystery player to guess
+	Attempts           int               // Number of valid guesses made
+	HintsUsed          int               // Number of attribute hints used
+	UsedHintAttributes map[string]bool   // Attributes already revealed by hints
+	History            []GuessRecord     // Every valid guess in the order it was made
+	StartTime          time.Time         // When the game started
+	Deadline           time.Time         // When the time limit expires (zero when the game is untimed)
+	DailyDate          string            // Date key of the daily challenge this game belongs to ("" if not a daily)
+	WeeklyKey          string            // ISO week of the weekly challenge this game belongs to ("" if not a weekly)
+	WeeklyDay          int               // Puzzle day within the weekly challenge (1-7)
+	StarterClue        string            // Attribute revealed by the free starter clue ("" if none)
+	Pinned             map[string]string // Attribute values confirmed in attribute mode
+	PaceStart          time.Time         // When the current -pace window began (last guess or penalty)
+	TimeTrades         int               // Attempts already traded for extra time with the 'time' command
+	SkippedTarget      string            // Mystery player replaced with the 'skip' command ("" if none)
+	AnswerShown        bool              // Whether the mystery player was revealed at the end of the game
+	QuizPoints         int               // Bonus points earned in the post-game -quiz
+	NameHintsShown     map[int]bool      // Levels of the automatic name hints already shown
+	Clock              Clock             // Tells the time for the deadline, splits, and pace (nil means the wall clock)
+}
+
+// skipTarget replaces the mystery player with a fresh random one and restarts the board without
+// penalty, for targets whose missing data makes the game unfair; it works once per game and
+// never in seeded or chosen-player games, where everyone must get the same answer
+func (g *Game) skipTarget() error {
+	switch {
+	case g.SkippedTarget != "":
+		return fmt.Errorf("you already skipped a mystery player this game")
+	case g.DailyDate != "" || g.WeeklyKey != "":
+		return fmt.Errorf("the daily and weekly puzzles can't be skipped - everyone plays the same player")
+	case g.Config.TargetName != "":
+		return fmt.Errorf("the mystery player was chosen with -target-name and can't be skipped")
+	}
+	next, err := getNextRandomPlayer(g.Target, g.Config)
+	if err != nil {
+		return err
+	}
+	skipped := g.Target.Name
+	fresh := newGame(g.Config, next)
+	if g.Config.Endurance > 0 {
+		fresh.Deadline = g.Deadline // The shared endurance clock keeps running
+	}
+	fresh.SkippedTarget = skipped
+	*g = *fresh
+	return nil
+}
+
+// maxTimeTrades is how many attempts can be traded for extra time in one game
+const maxTimeTrades = 3
+
+// newGame creates a fresh game against the given target, starting the clock now
+func newGame(config GameConfig, target Player) *Game {
+	startTime := config.clock().Now()
+	game := &Game{
+		Config:             config,
+		Target:             target,
+		UsedHintAttributes: make(map[string]bool),
+		NameHintsShown:     make(map[int]bool),
+		StartTime:          startTime,
+		PaceStart:          startTime,
+		Clock:              config.clock(),
+	}
+	if !config.NoTimeLimit {
+		game.Deadline = startTime.Add(config.TimeLimit)
+	}
+	return game
+}
+
+// recordGuess counts a valid guess, compares it with the target, and stores it in the history
+func (g *Game) recordGuess(guess Player) ComparisonResult {
+	g.Attempts++
+	result := compareWithTarget(guess, g.Target, g.Config)
+	g.History = append(g.History, GuessRecord{Player: guess, Result: result, At: g.now()})
+	g.PaceStart = g.now() // A guess starts a new pace window
+	return result
+}
+
+// renderRecord formats a guess for display in the configured layout
+func (g *Game) renderRecord(record GuessRecord) string {
+	if g.Config.Compact {
+		return record.Result.compactString(record.Player, g.Config)
+	}
+	style := g.Config.tableStyle()
+	row := record.Result.tableString(style)
+	if g.Config.Blind {
+		row = record.Result.blindString(record.Player, g.Config.theme(), style)
+	}
+	if style.Border {
+		row += "\n" + style.rule("-") // Close the row's box
+	}
+	return row
+}
+
+// recentHistory returns the last limit guesses and how many earlier ones it leaves out, slicing
+// rather than copying so showing a long game's board costs only the guesses shown; 0 returns all
+func (g *Game) recentHistory(limit int) ([]GuessRecord, int) {
+	if limit <= 0 || len(g.History) <= limit {
+		return g.History, 0
+	}
+	hidden := len(g.History) - limit
+	return g.History[hidden:], hidden
+}
+
+// printRecord prints a guess in the configured layout, revealing it cell by cell with -animate
+func (g *Game) printRecord(record GuessRecord) {
+	row := g.renderRecord(record)
+	if !animationEnabled(g.Config, isTerminal(os.Stdout)) {
+		fmt.Println(row)
+		return
+	}
+	separator := g.Config.tableStyle().Separator // Table and blind layouts
+	if g.Config.Compact {
+		separator = " "
+	}
+	revealRow(row, separator, cellRevealDelay)
+}
+
+// splits returns how long each guess took, measured from the previous guess or the start of the game
+func (g *Game) splits() []time.Duration {
+	splits := make([]time.Duration, len(g.History))
+	previous := g.StartTime
+	for i, record := range g.History {
+		splits[i] = record.At.Sub(previous)
+		previous = record.At
+	}
+	return splits
+}
+
+// averageSplit returns the mean time per guess, or 0 before the first guess
+func (g *Game) averageSplit() time.Duration {
+	if len(g.History) == 0 {
+		return 0
+	}
+	return g.History[len(g.History)-1].At.Sub(g.StartTime) / time.Duration(len(g.History))
+}
+
+// isConsistentCandidate reports whether the candidate could still be the mystery player:
+// every earlier guess would have produced exactly the same markers against them
+func isConsistentCandidate(candidate Player, history []GuessRecord, config GameConfig) bool {
+	for _, record := range history {
+		statuses := compareWithTarget(record.Player, candidate, config).Statuses
+		for attribute, status := range record.Result.Statuses {
+			if statuses[attribute] != status {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// remainingCandidates returns the possible mystery players still consistent with every guess
+func (g *Game) remainingCandidates() []Player {
+	var candidates []Player
+	for _, player := range eligibleTargets(store.Players(), g.Config) {
+		if isConsistentCandidate(player, g.History, g.Config) {
+			candidates = append(candidates, player)
+		}
+	}
+	return candidates
+}
+
+// unlearnedAttributes returns the attributes the player never pinned down: no guess matched
+// them exactly and no hint revealed them
+func (g *Game) unlearnedAttributes() []string {
+	var unlearned []string
+	for _, attribute := range comparedAttributes {
+		if attribute == "name" || g.UsedHintAttributes[attribute] {
+			continue
+		}
+		if _, pinned := g.Pinned[attribute]; pinned {
+			continue // Confirmed in attribute mode
+		}
+		learned := false
+		for _, record := range g.History {
+			if record.Result.Statuses[attribute] == MatchExact {
+				learned = true
+				break
+			}
+		}
+		if !learned {
+			unlearned = append(unlearned, attribute)
+		}
+	}
+	return unlearned
+}
+
+// knownAttribute is an attribute value the player has pinned down exactly
+type knownAttribute struct {
+	Attribute string // Attribute name
+	Value     string // The mystery player's value
+	Source    string // How it was learned, e.g. the guess that matched it
+}
+
+// knownAttributes returns, in table column order, every attribute a guess matched exactly or
+// attribute mode confirmed, with the value that was learned
+func (g *Game) knownAttributes() []knownAttribute {
+	var known []knownAttribute
+	for _, attribute := range comparedAttributes {
+		if attribute == "name" {
+			continue
+		}
+		if value, pinned := g.Pinned[attribute]; pinned {
+			known = append(known, knownAttribute{Attribute: attribute, Value: value, Source: "attribute guess"})
+			continue
+		}
+		for _, record := range g.History {
+			if record.Result.Statuses[attribute] == MatchExact {
+				known = append(known, knownAttribute{Attribute: attribute, Value: attributeValue(record.Player, attribute, g.Config), Source: record.Player.Name})
+				break
+			}
+		}
+	}
+	return known
+}
+
+// revealedHintAttributes returns the attributes hints and the starter clue revealed, in hint order
+func (g *Game) revealedHintAttributes() []string {
+	var revealed []string
+	for _, attribute := range append(append([]string{}, hintAttributes...), ladderOnlyAttributes...) {
+		if g.UsedHintAttributes[attribute] {
+			revealed = append(revealed, attribute)
+		}
+	}
+	return revealed
+}
+
+// printInfo summarizes everything the player has definitely learned: exact matches with their
+// values and every revealed hint. It only repeats what was already shown, so it's always free
+func printInfo(game *Game) {
+	known := game.knownAttributes()
+	revealed := game.revealedHintAttributes()
+	if len(known) == 0 && len(revealed) == 0 {
+		fmt.Println("📋 Nothing is known for sure yet - exact matches and hints will show up here.")
+		return
+	}
+	theme := game.Config.theme()
+	if len(known) > 0 {
+		fmt.Println("📋 Confirmed by your guesses:")
+		for _, fact := range known {
+			fmt.Printf("  %s %-13s %s (from %s)\n", theme.marker(MatchExact), attributeDisplayNames[fact.Attribute], fact.Value, fact.Source)
+		}
+	}
+	if len(revealed) > 0 {
+		fmt.Println("📋 Revealed by hints:")
+		for _, attribute := range revealed {
+			label := "💡"
+			if attribute == game.StarterClue {
+				label = "🎁"
+			}
+			fmt.Printf("  %s %s\n", label, describeAttribute(game.Target, attribute, game.Config))
+		}
+	}
+}
+
+// isCorrect reports whether the guessed player is the mystery player (case-insensitive name match)
+func (g *Game) isCorrect(guess Player) bool {
+	return strings.ToLower(guess.Name) == strings.ToLower(g.Target.Name)
+}
+
+// hasGuessed reports whether the player already appears in the guess history
+func (g *Game) hasGuessed(player Player) bool {
+	for _, record := range g.History {
+		if samePlayer(record.Player, player) {
+			return true
+		}
+	}
+	return false
+}
+
+// attemptsLeft returns how many guesses remain
+func (g *Game) attemptsLeft() int {
+	if g.Config.UnlimitedAttempts {
+		return math.MaxInt // Never runs out
+	}
+	return g.Config.MaxAttempts - g.Attempts
+}
+
+// attemptLabel describes the upcoming attempt, e.g. "3/8", or just "3" with unlimited attempts
+func (g *Game) attemptLabel() string {
+	if g.Config.UnlimitedAttempts {
+		return fmt.Sprintf("%d", g.Attempts+1)
+	}
+	return fmt.Sprintf("%d/%d", g.Attempts+1, g.Config.MaxAttempts)
+}
+
+// dueNameHint returns the level of the automatic name hint to show now, or false when none
+// is due, and marks it shown; a hint whose attempt was skipped over (by a time trade, a pace
+// penalty, or a hardcore miss) still fires, and when several are due only the strongest is shown
+func (g *Game) dueNameHint() (int, bool) {
+	if g.NameHintsShown == nil {
+		g.NameHintsShown = make(map[int]bool)
+	}
+	level, due := 0, false
+	for _, hint := range g.Config.NameHints {
+		if hint.Attempt <= g.Attempts && !g.NameHintsShown[hint.Level] {
+			g.NameHintsShown[hint.Level] = true
+			level, due = max(level, hint.Level), true
+		}
+	}
+	return level, due
+}
+
+// hintsLeft returns how many attribute hints remain
+func (g *Game) hintsLeft() int {
+	return g.Config.MaxHints - g.HintsUsed
+}
+
+// hintsAvailable returns how many hints can still actually be used: none once every
+// attribute has been revealed, even if the hint budget isn't spent
+func (g *Game) hintsAvailable() int {
+	for _, attribute := range hintAttributes {
+		if !g.UsedHintAttributes[attribute] {
+			return g.hintsLeft()
+		}
+	}
+	return 0
+}
+
+// tradeAttemptForTime spends one attempt to push the deadline back by the -time-trade amount
+// Returns an error explaining why when the trade isn't allowed
+func (g *Game) tradeAttemptForTime() error {
+	switch {
+	case g.Config.TimeTrade <= 0:
+		return fmt.Errorf("trading attempts for time is off (enable it with -time-trade)")
+	case !g.isTimed():
+		return fmt.Errorf("this game has no time limit")
+	case g.Config.Endurance > 0:
+		return fmt.Errorf("the endurance clock can't be extended")
+	case g.Config.UnlimitedAttempts:
+		return fmt.Errorf("attempts are unlimited, so there's nothing to trade")
+	case g.TimeTrades >= maxTimeTrades:
+		return fmt.Errorf("you've already traded %d attempts for time this game", maxTimeTrades)
+	case g.attemptsLeft() <= 1:
+		return fmt.Errorf("you can't trade your last attempt")
+	}
+	g.Attempts++
+	g.TimeTrades++
+	g.Deadline = g.Deadline.Add(g.Config.TimeTrade)
+	return nil
+}
+
+// isTimed reports whether the game has a time limit
+func (g *Game) isTimed() bool {
+	return !g.Deadline.IsZero()
+}
+
+// now returns the current time on the game's clock
+func (g *Game) now() time.Time {
+	if g.Clock == nil {
+		return time.Now() // Games built without newGame run on the wall clock
+	}
+	return g.Clock.Now()
+}
+
+// after returns a channel that fires once the duration has passed on the game's clock
+func (g *Game) after(duration time.Duration) <-chan time.Time {
+	if g.Clock == nil {
+		return time.After(duration)
+	}
+	return g.Clock.After(duration)
+}
+
+// elapsed returns how long the game has been running
+func (g *Game) elapsed() time.Duration {
+	return g.now().Sub(g.StartTime)
+}
+
+// timeRemaining returns how long is left before the deadline
+func (g *Game) timeRemaining() time.Duration {
+	return g.Deadline.Sub(g.now())
+}
+
+// timeExpired reports whether a timed game has passed its deadline
+func (g *Game) timeExpired() bool {
+	return g.isTimed() && g.now().After(g.Deadline)
+}
+
+// timeout returns a channel that fires when time runs out, or nil (never fires) for untimed games
+func (g *Game) timeout() <-chan time.Time {
+	if !g.isTimed() {
+		return nil
+	}
+	return g.after(g.timeRemaining())
+}
+
+// paceTimeout returns a channel that fires when the -pace window runs out, or nil when there is no pace
+func (g *Game) paceTimeout() <-chan time.Time {
+	if g.Config.Pace <= 0 {
+		return nil
+	}
+	return g.after(g.PaceStart.Add(g.Config.Pace).Sub(g.now()))
+}
+
+// applyPacePenalty forfeits a hint for a missed pace window, or an attempt when no hints are left,
+// starts a new window, and describes the penalty
+func (g *Game) applyPacePenalty() string {
+	g.PaceStart = g.now()
+	if g.hintsAvailable() > 0 {
+		g.HintsUsed++
+		return fmt.Sprintf("lost a hint (%d left)", g.hintsLeft())
+	}
+	g.Attempts++
+	if g.Config.UnlimitedAttempts {
+		return "lost an attempt"
+	}
+	return fmt.Sprintf("lost an attempt (%d left)", g.attemptsLeft())
+}
@@ -0,0 +1,425 @@
+package main
+
+import (
+	"math/rand"     // Package for a seeded random source
+	"os"            // Package for checking whether stdout is a terminal
+	"path/filepath" // Package for building file paths
+	"reflect"       // Package for comparing attribute lists
+	"testing"       // Package for Go tests
+	"time"          // Package for time-related operations
+)
+
+// newTestGame starts a game against the first fallback player on a fake clock
+func newTestGame(t *testing.T, change func(*GameConfig)) (*Game, *fakeClock) {
+	t.Helper()
+	clock := newFakeClock()
+	config := defaultConfig()
+	config.Clock = clock
+	if change != nil {
+		change(&config)
+	}
+	return newGame(config, getFallbackPlayers()[0]), clock
+}
+
+// testGuess returns a fallback player other than the target, to spend attempts on
+func testGuess(game *Game) Player {
+	for _, player := range getFallbackPlayers() {
+		if !game.isCorrect(player) {
+			return player
+		}
+	}
+	panic("every fallback player is the target")
+}
+
+// TestDueNameHint checks that each scheduled name hint fires once, on time
+func TestDueNameHint(t *testing.T) {
+	game, _ := newTestGame(t, func(c *GameConfig) {
+		c.NameHints = []nameHint{{Attempt: 2, Level: 1}, {Attempt: 4, Level: 2}}
+	})
+	want := map[int]int{2: 1, 4: 2} // Attempts -> level shown after that guess
+	for attempt := 1; attempt <= 6; attempt++ {
+		game.recordGuess(testGuess(game))
+		level, due := game.dueNameHint()
+		if wantLevel, scheduled := want[attempt]; due != scheduled || level != wantLevel {
+			t.Errorf("after guess %d: dueNameHint() = %d, %v; want %d, %v", attempt, level, due, wantLevel, scheduled)
+		}
+	}
+}
+
+// TestDueNameHintAfterSkippedAttempt checks that a hint still fires when its attempt is
+// jumped over, and that only the strongest of several due hints is shown
+func TestDueNameHintAfterSkippedAttempt(t *testing.T) {
+	game, _ := newTestGame(t, func(c *GameConfig) {
+		c.NameHints = []nameHint{{Attempt: 2, Level: 1}, {Attempt: 3, Level: 2}, {Attempt: 6, Level: 3}}
+	})
+	game.recordGuess(testGuess(game))
+	game.Attempts += 2 // A time trade and a pace penalty skip attempts 2 and 3
+	game.recordGuess(testGuess(game))
+	if level, due := game.dueNameHint(); !due || level != 2 {
+		t.Fatalf("after jumping to %d attempts: dueNameHint() = %d, %v; want 2, true", game.Attempts, level, due)
+	}
+	if _, due := game.dueNameHint(); due {
+		t.Error("a shown name hint fired again")
+	}
+	game.Attempts = 6
+	if level, due := game.dueNameHint(); !due || level != 3 {
+		t.Errorf("dueNameHint() = %d, %v; want 3, true", level, due)
+	}
+}
+
+// TestGameClock checks that the deadline, the time left, and the pace all follow the game's clock
+func TestGameClock(t *testing.T) {
+	game, clock := newTestGame(t, func(c *GameConfig) {
+		c.TimeLimit = time.Minute
+		c.Pace = 10 * time.Second
+	})
+	if got := game.timeRemaining(); got != time.Minute {
+		t.Fatalf("timeRemaining() at the start = %v, want 1m", got)
+	}
+	timeout, pace := game.timeout(), game.paceTimeout()
+
+	clock.Advance(10 * time.Second)
+	if !fired(pace) || fired(timeout) {
+		t.Fatal("after 10s the pace window should run out before the time limit")
+	}
+	hints := game.hintsLeft()
+	game.applyPacePenalty()
+	if game.hintsLeft() != hints-1 || !game.PaceStart.Equal(clock.Now()) {
+		t.Errorf("pace penalty: %d hints left (want %d), window restarted at %v (want %v)", game.hintsLeft(), hints-1, game.PaceStart, clock.Now())
+	}
+
+	clock.Advance(49 * time.Second)
+	if game.timeExpired() || game.timeRemaining() != time.Second {
+		t.Errorf("with 1s left: timeExpired() = %v, timeRemaining() = %v", game.timeExpired(), game.timeRemaining())
+	}
+	clock.Advance(2 * time.Second)
+	if !fired(timeout) || !game.timeExpired() {
+		t.Error("the time limit passed but the game didn't expire")
+	}
+	if got := game.elapsed(); got != 61*time.Second {
+		t.Errorf("elapsed() = %v, want 1m1s", got)
+	}
+}
+
+// TestTradeAttemptForTime checks that a trade pushes the deadline back and spends an attempt,
+// up to maxTimeTrades per game
+func TestTradeAttemptForTime(t *testing.T) {
+	game, clock := newTestGame(t, func(c *GameConfig) {
+		c.TimeLimit = time.Minute
+		c.TimeTrade = 30 * time.Second
+		c.MaxAttempts = 10
+	})
+	deadline := game.Deadline
+	for trade := 1; trade <= maxTimeTrades; trade++ {
+		if err := game.tradeAttemptForTime(); err != nil {
+			t.Fatalf("trade %d: %v", trade, err)
+		}
+		if want := deadline.Add(time.Duration(trade) * game.Config.TimeTrade); !game.Deadline.Equal(want) {
+			t.Errorf("after trade %d the deadline is %v, want %v", trade, game.Deadline, want)
+		}
+		if game.Attempts != trade || game.TimeTrades != trade {
+			t.Errorf("after trade %d: Attempts = %d, TimeTrades = %d", trade, game.Attempts, game.TimeTrades)
+		}
+	}
+	if err := game.tradeAttemptForTime(); err == nil {
+		t.Errorf("trade %d was allowed", maxTimeTrades+1)
+	}
+	if game.Attempts != maxTimeTrades {
+		t.Errorf("a refused trade still spent an attempt: Attempts = %d", game.Attempts)
+	}
+
+	// The extension is real time: the game outlasts its original limit
+	clock.Advance(time.Minute + time.Second)
+	if game.timeExpired() {
+		t.Error("the game expired at its original deadline despite the trades")
+	}
+}
+
+// TestTradeAttemptForTimeRefused checks the games a trade is never allowed in
+func TestTradeAttemptForTimeRefused(t *testing.T) {
+	tests := map[string]func(*GameConfig){
+		"trading off":        func(c *GameConfig) { c.TimeTrade = 0 },
+		"untimed":            func(c *GameConfig) { c.NoTimeLimit = true },
+		"endurance":          func(c *GameConfig) { c.Endurance = time.Hour },
+		"unlimited attempts": func(c *GameConfig) { c.UnlimitedAttempts = true },
+		"last attempt":       func(c *GameConfig) { c.MaxAttempts = 1 },
+	}
+	for name, change := range tests {
+		game, _ := newTestGame(t, func(c *GameConfig) {
+			c.TimeTrade = 30 * time.Second
+			change(c)
+		})
+		if err := game.tradeAttemptForTime(); err == nil {
+			t.Errorf("%s: the trade was allowed", name)
+		}
+	}
+}
+
+// TestNameHintAfterTimeTrade checks that the attempt a trade skips over still brings its name hint
+func TestNameHintAfterTimeTrade(t *testing.T) {
+	game, _ := newTestGame(t, func(c *GameConfig) {
+		c.TimeTrade = 30 * time.Second
+		c.NameHints = []nameHint{{Attempt: 2, Level: 1}}
+	})
+	game.recordGuess(testGuess(game))
+	if err := game.tradeAttemptForTime(); err != nil {
+		t.Fatal(err)
+	}
+	game.recordGuess(testGuess(game)) // Attempt 3: attempt 2 was traded away
+	if level, due := game.dueNameHint(); !due || level != 1 {
+		t.Errorf("dueNameHint() = %d, %v; want 1, true", level, due)
+	}
+}
+
+// TestSplits checks each guess's split and the average, timed on the game's clock
+func TestSplits(t *testing.T) {
+	game, clock := newTestGame(t, nil)
+	if game.averageSplit() != 0 || len(game.splits()) != 0 {
+		t.Fatal("a game without guesses has splits")
+	}
+	for _, wait := range []time.Duration{12 * time.Second, 3 * time.Second, 45 * time.Second} {
+		clock.Advance(wait)
+		game.recordGuess(testGuess(game))
+	}
+	want := []time.Duration{12 * time.Second, 3 * time.Second, 45 * time.Second}
+	splits := game.splits()
+	if len(splits) != len(want) {
+		t.Fatalf("got %d splits, want %d", len(splits), len(want))
+	}
+	for i := range want {
+		if splits[i] != want[i] {
+			t.Errorf("split %d = %v, want %v", i+1, splits[i], want[i])
+		}
+	}
+	if got := game.averageSplit(); got != 20*time.Second {
+		t.Errorf("averageSplit() = %v, want 20s", got)
+	}
+
+	// Time spent after the last guess doesn't change the average
+	clock.Advance(time.Minute)
+	if got := game.averageSplit(); got != 20*time.Second {
+		t.Errorf("averageSplit() after waiting = %v, want 20s", got)
+	}
+}
+
+// TestRecentHistory checks the board limit's edge cases: off, negative, and larger than the history
+func TestRecentHistory(t *testing.T) {
+	game, _ := newTestGame(t, func(c *GameConfig) { c.MaxAttempts = 10 })
+	for i := 0; i < 5; i++ {
+		game.recordGuess(testGuess(game))
+	}
+	tests := []struct {
+		limit, shown, hidden int
+	}{
+		{0, 5, 0},  // 0 shows every guess
+		{-3, 5, 0}, // Negative limits are treated like 0
+		{10, 5, 0}, // More than the history shows all of it
+		{5, 5, 0},
+		{2, 2, 3},
+		{1, 1, 4},
+	}
+	for _, test := range tests {
+		shown, hidden := game.recentHistory(test.limit)
+		if len(shown) != test.shown || hidden != test.hidden {
+			t.Errorf("recentHistory(%d) shows %d and hides %d, want %d and %d", test.limit, len(shown), hidden, test.shown, test.hidden)
+		}
+		if len(shown) > 0 && &shown[len(shown)-1] != &game.History[len(game.History)-1] {
+			t.Errorf("recentHistory(%d) doesn't end with the latest guess", test.limit)
+		}
+	}
+
+	empty, _ := newTestGame(t, nil)
+	if shown, hidden := empty.recentHistory(3); len(shown) != 0 || hidden != 0 {
+		t.Errorf("a game without guesses shows %d and hides %d", len(shown), hidden)
+	}
+}
+
+// TestNameHintSchedules checks that each difficulty's name hints fire at its configured
+// attempts and at no others, and that hardcore turns them off
+func TestNameHintSchedules(t *testing.T) {
+	tests := []struct {
+		difficulty string
+		hardcore   bool
+		want       map[int]int // Attempts -> level shown after that guess
+	}{
+		{"easy", false, map[int]int{4: 1, 6: 2, 9: 3}},
+		{"normal", false, map[int]int{4: 1, 6: 2, 7: 3}},
+		{"hard", false, nil},
+		{"easy", true, nil},
+	}
+	for _, test := range tests {
+		game, _ := newTestGame(t, func(c *GameConfig) {
+			c.applyDifficulty(test.difficulty)
+			c.Hardcore = test.hardcore
+			c.applyModes()
+		})
+		for attempt := 1; attempt <= 10; attempt++ {
+			game.recordGuess(testGuess(game))
+			level, due := game.dueNameHint()
+			if wantLevel, scheduled := test.want[attempt]; due != scheduled || level != wantLevel {
+				t.Errorf("%s (hardcore %v) after guess %d: dueNameHint() = %d, %v; want %d, %v",
+					test.difficulty, test.hardcore, attempt, level, due, wantLevel, scheduled)
+			}
+		}
+	}
+}
+
+// TestRemainingCandidatesShrink checks that the remaining-candidates count never grows as
+// guesses are made, always includes the mystery player, and reaches one once it's guessed
+func TestRemainingCandidatesShrink(t *testing.T) {
+	players := useFallbackPlayers(t)
+	game, _ := newTestGame(t, nil)
+	previous := len(game.remainingCandidates())
+	if previous != len(eligibleTargets(players, game.Config)) {
+		t.Fatalf("%d candidates before any guess, want every eligible target", previous)
+	}
+
+	for _, guess := range players[1:] {
+		game.recordGuess(guess)
+		candidates := game.remainingCandidates()
+		if len(candidates) > previous {
+			t.Errorf("after guessing %s: %d candidates, up from %d", guess.Name, len(candidates), previous)
+		}
+		found := false
+		for _, candidate := range candidates {
+			found = found || candidate.Name == game.Target.Name
+		}
+		if !found {
+			t.Fatalf("after guessing %s the mystery player is no longer a candidate", guess.Name)
+		}
+		previous = len(candidates)
+	}
+	game.recordGuess(game.Target)
+	if got := len(game.remainingCandidates()); got != 1 {
+		t.Errorf("%d candidates after guessing the mystery player, want 1", got)
+	}
+}
+
+// TestUnlearnedAttributes checks that only attributes no guess matched exactly and no hint or
+// attribute guess revealed are reported as never learned
+func TestUnlearnedAttributes(t *testing.T) {
+	game, _ := newTestGame(t, nil)
+	all := comparedAttributes[1:]
+	if got := game.unlearnedAttributes(); !reflect.DeepEqual(got, all) {
+		t.Fatalf("before any guess: %v, want every attribute", got)
+	}
+
+	guess, _ := comparePlayers(func(p *Player) {
+		p.Team, p.Position, p.Height, p.Weight, p.College = "Boston Celtics", "C", "7'3\"", 300, "Nowhere State"
+		p.DraftYear, p.DraftRound, p.DraftNumber = 1990, 2, 55
+		p.JerseyNumber = "99"
+	}, nil) // Only the country matches exactly
+	game.recordGuess(guess)
+	near, _ := comparePlayers(func(p *Player) {
+		p.Team, p.Position, p.College, p.Country = "Boston Celtics", "C", "Nowhere State", "Nowhere"
+		p.Height = "6'10\"" // One inch off: close, not learned
+		p.DraftYear, p.DraftRound, p.DraftNumber = 1990, 2, 55
+		p.JerseyNumber = "99"
+	}, nil) // Weight matches exactly
+	game.recordGuess(near)
+	game.UsedHintAttributes["college"] = true
+	game.Pinned = map[string]string{"jerseynumber": game.Target.JerseyNumber}
+
+	want := []string{"team", "position", "height", "draftyear", "draftround", "draftnumber"}
+	if got := game.unlearnedAttributes(); !reflect.DeepEqual(got, want) {
+		t.Errorf("unlearnedAttributes() = %v, want %v", got, want)
+	}
+}
+
+// TestPrintRecordSkipsAnimationWhenPiped checks that -animate prints rows at full speed when
+// output isn't a terminal, as under go test
+func TestPrintRecordSkipsAnimationWhenPiped(t *testing.T) {
+	if isTerminal(os.Stdout) {
+		t.Skip("stdout is a terminal")
+	}
+	if animationEnabled(defaultConfig(), false) {
+		t.Fatal("animation is on by default")
+	}
+	game, _ := newTestGame(t, func(config *GameConfig) { config.AnimateCells = true })
+	for _, player := range getFallbackPlayers()[1:6] {
+		game.recordGuess(player)
+	}
+
+	start := time.Now()
+	for _, record := range game.History {
+		game.printRecord(record) // Animated, each row would take over a second
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("printing %d piped rows with -animate took %v", len(game.History), elapsed)
+	}
+}
+
+// TestIncompleteTargets checks that random picks re-roll away from players with too much
+// missing data, and that a mystery player can be skipped once per random game
+func TestIncompleteTargets(t *testing.T) {
+	players := useFallbackPlayers(t)
+	sparse := players[0]
+	sparse.Name, sparse.Height, sparse.Weight, sparse.College = "Test Sparse", "Unknown", 0, "Unknown"
+	pool := append([]Player{sparse}, players[1:4]...)
+	usePlayers(t, pool)
+	if isComplete(sparse) {
+		t.Fatalf("%s is missing %v but counts as complete", sparse.Name, missingAttributes(sparse))
+	}
+
+	config := defaultConfig()
+	for seed := int64(1); seed <= 50; seed++ {
+		config.Rand = rand.New(rand.NewSource(seed))
+		if target, err := getRandomPlayer(config); err != nil || target.Name == sparse.Name {
+			t.Fatalf("seed %d: picked %s, %v; want a complete player", seed, target.Name, err)
+		}
+	}
+
+	game, _ := newTestGame(t, func(config *GameConfig) { config.SaveFile = filepath.Join(t.TempDir(), "save.json") })
+	game.Target = sparse // As if it came from a file without better players
+	playLines(game, "skip", "skip")
+	if game.SkippedTarget != sparse.Name || game.Target.Name == sparse.Name {
+		t.Errorf("after skipping: target %s, skipped %q; want a new target and %s skipped", game.Target.Name, game.SkippedTarget, sparse.Name)
+	}
+	if err := game.skipTarget(); err == nil {
+		t.Error("a second skip in the same game was allowed")
+	}
+
+	daily, _ := newTestGame(t, nil)
+	daily.DailyDate = "2024-03-15"
+	if err := daily.skipTarget(); err == nil || daily.Target.Name != players[0].Name {
+		t.Errorf("skipping the daily player: %v, target %s", err, daily.Target.Name)
+	}
+}
+
+// TestKnownAttributes checks that exactly the attributes matched by a guess or confirmed in
+// attribute mode are listed, in column order, with the value and where it came from; close
+// matches and hints don't count
+func TestKnownAttributes(t *testing.T) {
+	game, _ := newTestGame(t, nil)
+	if known := game.knownAttributes(); len(known) != 0 {
+		t.Fatalf("before any guess: %v, want nothing", known)
+	}
+
+	far, _ := comparePlayers(func(p *Player) {
+		p.Name = "Far Player"
+		p.Team, p.Position, p.Height, p.Weight, p.College = "Boston Celtics", "C", "7'3\"", 300, "Nowhere State"
+		p.DraftYear, p.DraftRound, p.DraftNumber = 1990, 2, 55
+		p.JerseyNumber = "99"
+	}, nil) // Only the country matches exactly
+	game.recordGuess(far)
+	near, _ := comparePlayers(func(p *Player) {
+		p.Name = "Near Player"
+		p.Team, p.Position, p.College = "Boston Celtics", "C", "Nowhere State"
+		p.Height = "6'10\"" // One inch off: close, not known
+		p.DraftYear, p.DraftRound, p.DraftNumber = 1990, 2, 55
+		p.JerseyNumber = "99"
+	}, nil) // Weight and country match exactly
+	game.recordGuess(near)
+	game.UsedHintAttributes["college"] = true // Hints are listed separately
+	game.Pinned = map[string]string{"jerseynumber": game.Target.JerseyNumber}
+
+	target := game.Target
+	want := []knownAttribute{
+		{"weight", attributeValue(target, "weight", game.Config), "Near Player"},
+		{"jerseynumber", target.JerseyNumber, "attribute guess"},
+		{"country", target.Country, "Far Player"}, // Credited to the first guess that matched it
+	}
+	if got := game.knownAttributes(); !reflect.DeepEqual(got, want) {
+		t.Errorf("knownAttributes() = %+v\nwant %+v", got, want)
+	}
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"encoding/csv" // Package for writing CSV files with proper quoting
+	"fmt"          // Package for formatted I/O operations
+	"os"           // Package for file operations
+	"strconv"      // Package for converting numbers to strings
+)
+
+// csvHeader returns the CSV column names: game, attempt, guess, then a status and value column per attribute
+func csvHeader() []string {
+	header := []string{"game", "attempt", "guess"}
+	for _, attribute := range comparedAttributes {
+		if attribute == "name" {
+			continue // The guessed name already has its own column
+		}
+		header = append(header, attribute+"_status", attribute+"_value")
+	}
+	return header
+}
+
+// writeSessionCSV writes every guess of the session's games to a CSV file, one row per guess
+func writeSessionCSV(path string, games []*Game) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create CSV file: %v", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file) // Quotes names containing commas or quotes
+	writer.Write(csvHeader())
+	for gameNumber, game := range games {
+		for attempt, record := range game.History {
+			row := []string{strconv.Itoa(gameNumber + 1), strconv.Itoa(attempt + 1), record.Player.Name}
+			for _, attribute := range comparedAttributes {
+				if attribute == "name" {
+					continue
+				}
+				row = append(row, record.Result.Statuses[attribute].String(), attributeValue(record.Player, attribute, game.Config))
+			}
+			writer.Write(row)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("failed to write CSV file: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"encoding/csv"  // Package for reading the exported CSV back
+	"os"            // Package for file operations
+	"path/filepath" // Package for building file paths
+	"testing"       // Package for Go tests
+)
+
+// TestWriteSessionCSV checks the CSV written for a scripted two-game session
+func TestWriteSessionCSV(t *testing.T) {
+	useFallbackPlayers(t)
+	first, _ := newTestGame(t, nil)
+	miss := testGuess(first)
+	playLines(first, miss.Name, first.Target.Name)
+	second, _ := newTestGame(t, nil)
+	playLines(second, second.Target.Name)
+
+	path := filepath.Join(t.TempDir(), "guesses.csv")
+	if err := writeSessionCSV(path, []*Game{first, second}); err != nil {
+		t.Fatal(err)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	rows, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rows) != 4 {
+		t.Fatalf("got %d rows, want a header and 3 guesses", len(rows))
+	}
+	header := csvHeader()
+	if len(rows[0]) != len(header) || rows[0][3] != "team_status" || rows[0][4] != "team_value" {
+		t.Errorf("header = %v", rows[0])
+	}
+	want := [][]string{
+		{"1", "1", miss.Name},
+		{"1", "2", first.Target.Name},
+		{"2", "1", second.Target.Name},
+	}
+	for i, prefix := range want {
+		row := rows[i+1]
+		for j, value := range prefix {
+			if row[j] != value {
+				t.Errorf("row %d column %s = %q, want %q", i+1, header[j], row[j], value)
+			}
+		}
+	}
+
+	// The winning guess is exact everywhere; the miss carries its own values
+	team := attributeValue(miss, "team", first.Config)
+	if rows[1][4] != team {
+		t.Errorf("miss team_value = %q, want %q", rows[1][4], team)
+	}
+	for j := 3; j < len(header); j += 2 {
+		if rows[2][j] != "exact" {
+			t.Errorf("winning guess %s = %q, want exact", header[j], rows[2][j])
+		}
+	}
+}
@@ -0,0 +1,328 @@
+package main
+
+import (
+	"bufio"         // Package for reading files line by line
+	"encoding/json" // Package for JSON encoding and decoding
+	"fmt"           // Package for formatted I/O operations
+	"os"            // Package for file operations
+	"strings"       // Package for string manipulation functions
+)
+
+// isEligibleTarget reports whether a player may be chosen as the mystery player
+// Every rule about target quality lives here; ineligible players stay guessable
+func isEligibleTarget(player Player, config GameConfig) bool {
+	// A player without a known position makes a whole clue useless
+	if player.Position == "Unknown" {
+		return false
+	}
+
+	// A two-way player's team clue is misleading, since they spend much of the season in the G League
+	if player.ContractType == contractTwoWay && !config.IncludeTwoWay {
+		return false
+	}
+
+	// Draft-class games only pick players from that class
+	if config.DraftClass != 0 && player.DraftYear != config.DraftClass {
+		return false
+	}
+
+	// With a candidates file, the answer is always one of the listed players
+	if !isCandidate(player, config) {
+		return false
+	}
+
+	// Players the user asked never to be the answer
+	if config.ExcludedTargets[normalizeName(player.Name)] {
+		return false
+	}
+	return true
+}
+
+// eligibleTargets returns every player in the pool that may be chosen as the mystery player
+func eligibleTargets(pool []Player, config GameConfig) []Player {
+	var targets []Player
+	for _, player := range pool {
+		if isEligibleTarget(player, config) {
+			targets = append(targets, player)
+		}
+	}
+	return targets
+}
+
+// activeTargetFilters names every rule narrowing who in the pool can be the mystery player, by
+// the flag that sets it; the built-in rules are only named when the pool has players they skip
+// (-exclude-unknown isn't one: it removes players from the pool, not just from the targets)
+func activeTargetFilters(pool []Player, config GameConfig) []string {
+	var filters []string
+	if config.DraftClass != 0 {
+		filters = append(filters, fmt.Sprintf("-draft-class=%d", config.DraftClass))
+	}
+	if len(config.Candidates) > 0 {
+		filters = append(filters, fmt.Sprintf("-candidates-file (%d %s)", len(config.Candidates), unit(len(config.Candidates), "name", "names")))
+	}
+	if len(config.ExcludedTargets) > 0 {
+		filters = append(filters, fmt.Sprintf("-exclude/-exclude-file (%d %s)", len(config.ExcludedTargets), unit(len(config.ExcludedTargets), "name", "names")))
+	}
+
+	unknownPosition, twoWay := false, false
+	for _, player := range pool {
+		unknownPosition = unknownPosition || player.Position == "Unknown"
+		twoWay = twoWay || player.ContractType == contractTwoWay
+	}
+	if twoWay && !config.IncludeTwoWay {
+		filters = append(filters, "two-way players skipped (-include-twoway allows them)")
+	}
+	if unknownPosition {
+		filters = append(filters, "players with an unknown position skipped")
+	}
+	return filters
+}
+
+// noTargetsError explains why no mystery player can be chosen from the pool, naming the filters
+// that emptied it so the player knows what to loosen; it wraps errNoPlayers
+func noTargetsError(pool []Player, config GameConfig) error {
+	if len(pool) == 0 {
+		return fmt.Errorf("%w: the player pool is empty - check the players files or the API connection", errNoPlayers)
+	}
+	filters := activeTargetFilters(pool, config)
+	if len(filters) == 0 {
+		return fmt.Errorf("%w: none of the %d loaded players can be the mystery player", errNoPlayers, len(pool))
+	}
+	return fmt.Errorf("%w: none of the %d loaded players passes every filter (%s) - loosen or remove one of them",
+		errNoPlayers, len(pool), strings.Join(filters, ", "))
+}
+
+// targetSelectionKey summarizes every setting isEligibleTarget reads, so cached targets are
+// recomputed whenever one of them differs (maps print with sorted keys)
+func targetSelectionKey(config GameConfig) string {
+	return fmt.Sprintf("%t|%d|%q|%v", config.IncludeTwoWay, config.DraftClass, config.Candidates, config.ExcludedTargets)
+}
+
+// recentTargetNames returns the normalized names of the last window mystery players
+func recentTargetNames(recent []string, window int) map[string]bool {
+	names := make(map[string]bool)
+	if window <= 0 {
+		return names
+	}
+	for _, name := range recent[max(len(recent)-window, 0):] {
+		names[normalizeName(name)] = true
+	}
+	return names
+}
+
+// avoidRecent drops the most recent mystery players from the targets, looking back at most
+// window games but always leaving at least one player, so small pools still work
+func avoidRecent(targets []Player, recent []string, window int) []Player {
+	window = min(window, len(recent), len(targets)-1)
+	if window <= 0 {
+		return targets
+	}
+	avoided := recentTargetNames(recent, window)
+	var fresh []Player
+	for _, player := range targets {
+		if !avoided[normalizeName(player.Name)] {
+			fresh = append(fresh, player)
+		}
+	}
+	if len(fresh) == 0 {
+		return targets // Names repeated in the history can cover the whole pool
+	}
+	return fresh
+}
+
+// maxMissingAttributes is how many clue values a random mystery player may be missing;
+// with more, too many columns can never turn green and the game is unfair
+const maxMissingAttributes = 1
+
+// missingAttributes lists the clue values a player's data doesn't have
+// Free agents, undrafted players, and players who skipped college are complete - those are real answers
+func missingAttributes(player Player) []string {
+	var missing []string
+	if player.Position == "Unknown" {
+		missing = append(missing, "position")
+	}
+	if player.Height == "Unknown" {
+		missing = append(missing, "height")
+	}
+	if player.Weight == 0 {
+		missing = append(missing, "weight")
+	}
+	if player.College == "Unknown" {
+		missing = append(missing, "college")
+	}
+	if player.JerseyNumber == "Unknown" {
+		missing = append(missing, "jersey")
+	}
+	if player.Country == "Unknown" {
+		missing = append(missing, "country")
+	}
+	return missing
+}
+
+// isComplete reports whether a player has enough data to be a fair mystery player
+func isComplete(player Player) bool {
+	return len(missingAttributes(player)) <= maxMissingAttributes
+}
+
+// preferComplete drops players with too much missing data from the targets, so a random pick
+// never lands on one, but keeps every target when none is complete, so sparse pools still work
+func preferComplete(targets []Player) []Player {
+	var complete []Player
+	for _, player := range targets {
+		if isComplete(player) {
+			complete = append(complete, player)
+		}
+	}
+	if len(complete) == 0 {
+		return targets
+	}
+	return complete
+}
+
+// filterPool removes players from the whole pool (so they can't be guessed either) according to the config
+func filterPool(pool []Player, config GameConfig) []Player {
+	if !config.ExcludeUnknown && !config.DraftClassOnly {
+		return pool
+	}
+	var filtered []Player
+	for _, player := range pool {
+		if config.ExcludeUnknown && player.Position == "Unknown" {
+			continue
+		}
+		if config.DraftClassOnly && player.DraftYear != config.DraftClass {
+			continue
+		}
+		filtered = append(filtered, player)
+	}
+	return filtered
+}
+
+// minDraftClassSize is the fewest eligible players a -draft-class game needs to be worth playing
+const minDraftClassSize = 5
+
+// checkDraftClass returns an error when a -draft-class game has too few eligible mystery players
+func checkDraftClass(config GameConfig) error {
+	if config.DraftClass == 0 {
+		return nil
+	}
+	count := len(eligibleTargets(store.Players(), config))
+	if count < minDraftClassSize {
+		return fmt.Errorf("only %d player(s) from the %d draft class are available (at least %d needed) - try another year or load more players with -max-pages", count, config.DraftClass, minDraftClassSize)
+	}
+	return nil
+}
+
+// guessablePool returns the players a guess can name: the candidates in a -candidates game,
+// otherwise the whole pool
+func guessablePool(config GameConfig) []Player {
+	if len(config.Candidates) > 0 {
+		return candidatePlayers(config)
+	}
+	return store.Players()
+}
+
+// fitAttemptsToPool handles a pool so small that guessing every player fits in the attempt limit:
+// with -small-pool=adjust the limit drops to one less than the pool size, with warn it only says
+// so, and with off nothing happens. Returns the message to show, or "" when the pool is big enough
+func fitAttemptsToPool(config *GameConfig) string {
+	if config.SmallPool == "off" || config.UnlimitedAttempts || config.Mode == "team" {
+		return ""
+	}
+	size := len(guessablePool(*config))
+	if size == 0 || size > config.MaxAttempts {
+		return ""
+	}
+	if config.SmallPool == "warn" {
+		return fmt.Sprintf("Only %d players can be guessed, so %d attempts are enough to try them all (-small-pool=adjust lowers the limit)", size, config.MaxAttempts)
+	}
+	previous := config.MaxAttempts
+	config.MaxAttempts = max(size-1, 1)
+	return fmt.Sprintf("Only %d players can be guessed, so attempts are lowered from %d to %d (-small-pool=off keeps them)", size, previous, config.MaxAttempts)
+}
+
+// excludedTargetNames collects the normalized names from -exclude values (comma-separated)
+// and -exclude-file files (one name per line, # starts a comment)
+func excludedTargetNames(values, files []string) (map[string]bool, error) {
+	excluded := make(map[string]bool)
+	add := func(name string) {
+		if normalized := normalizeName(name); normalized != "" {
+			excluded[normalized] = true
+		}
+	}
+
+	for _, value := range values {
+		for _, name := range strings.Split(value, ",") {
+			add(name)
+		}
+	}
+
+	for _, path := range files {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read exclude file: %v", err)
+		}
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			add(line)
+		}
+		err = scanner.Err()
+		file.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read exclude file %s: %v", path, err)
+		}
+	}
+	return excluded, nil
+}
+
+// loadCandidatesFile reads a JSON array of player names that the mystery player is chosen from
+// Returns the normalized names in file order
+func loadCandidatesFile(path string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read candidates file: %v", err)
+	}
+	var names []string
+	if err := json.Unmarshal(data, &names); err != nil {
+		return nil, fmt.Errorf("failed to parse candidates file %s (expected a JSON array of names): %v", path, err)
+	}
+
+	var candidates []string
+	for _, name := range names {
+		if normalized := normalizeName(name); normalized != "" {
+			candidates = append(candidates, normalized)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("candidates file %s lists no players", path)
+	}
+	return candidates, nil
+}
+
+// isCandidate reports whether a player is in the configured candidate list (always true without one)
+func isCandidate(player Player, config GameConfig) bool {
+	if len(config.Candidates) == 0 {
+		return true
+	}
+	normalized := normalizeName(player.Name)
+	for _, candidate := range config.Candidates {
+		if candidate == normalized {
+			return true
+		}
+	}
+	return false
+}
+
+// candidatePlayers returns the loaded players named in the candidate list, in list order
+func candidatePlayers(config GameConfig) []Player {
+	var players []Player
+	for _, name := range config.Candidates {
+		if player, found := store.PlayerByName(name); found {
+			players = append(players, player)
+		}
+	}
+	return players
+}
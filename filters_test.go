@@ -0,0 +1,274 @@
+package main
+
+import (
+	"errors"        // Package for matching wrapped errors
+	"fmt"           // Package for formatted I/O operations
+	"math/rand"     // Package for a seeded random source
+	"os"            // Package for file operations
+	"path/filepath" // Package for building file paths
+	"reflect"       // Package for comparing filter lists
+	"strings"       // Package for string manipulation functions
+	"testing"       // Package for Go tests
+)
+
+// TestActiveTargetFilters checks that only the filters in effect are named, by their real flags
+func TestActiveTargetFilters(t *testing.T) {
+	regular := Player{Name: "Regular Player", Position: "G"}
+	twoWay := Player{Name: "Two Way", Position: "F", ContractType: contractTwoWay}
+	unknown := Player{Name: "No Position", Position: "Unknown"}
+
+	tests := []struct {
+		name   string
+		pool   []Player
+		change func(*GameConfig)
+		want   []string
+	}{
+		{"nothing active", []Player{regular}, nil, nil},
+		{"draft class", []Player{regular}, func(c *GameConfig) { c.DraftClass = 2003 }, []string{"-draft-class=2003"}},
+		{"candidates", []Player{regular}, func(c *GameConfig) { c.Candidates = []string{"a", "b"} },
+			[]string{"-candidates-file (2 names)"}},
+		{"excludes", []Player{regular}, func(c *GameConfig) { c.ExcludedTargets = map[string]bool{"a": true} },
+			[]string{"-exclude/-exclude-file (1 name)"}},
+		{"two-way players in the pool", []Player{regular, twoWay}, nil,
+			[]string{"two-way players skipped (-include-twoway allows them)"}},
+		{"two-way players allowed", []Player{twoWay}, func(c *GameConfig) { c.IncludeTwoWay = true }, nil},
+		{"unknown positions in the pool", []Player{unknown}, nil, []string{"players with an unknown position skipped"}},
+		{"exclude-unknown alone", []Player{regular}, func(c *GameConfig) { c.ExcludeUnknown = true }, nil},
+		{"everything", []Player{twoWay, unknown}, func(c *GameConfig) {
+			c.DraftClass = 1996
+			c.Candidates = []string{"a"}
+			c.ExcludedTargets = map[string]bool{"b": true, "c": true}
+		}, []string{
+			"-draft-class=1996",
+			"-candidates-file (1 name)",
+			"-exclude/-exclude-file (2 names)",
+			"two-way players skipped (-include-twoway allows them)",
+			"players with an unknown position skipped",
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := defaultConfig()
+			if test.change != nil {
+				test.change(&config)
+			}
+			if got := activeTargetFilters(test.pool, config); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("activeTargetFilters() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+// TestNoTargetsError checks that the error wraps errNoPlayers and names what emptied the pool
+func TestNoTargetsError(t *testing.T) {
+	config := defaultConfig()
+	config.DraftClass = 2003
+	err := noTargetsError([]Player{{Name: "Regular Player", Position: "G", DraftYear: 2010}}, config)
+	if !errors.Is(err, errNoPlayers) {
+		t.Errorf("noTargetsError() = %v, want it to wrap errNoPlayers", err)
+	}
+	if message := err.Error(); !strings.Contains(message, "(-draft-class=2003)") || strings.Contains(message, "unknown position") {
+		t.Errorf("noTargetsError() = %q, want only -draft-class named", message)
+	}
+	if err := noTargetsError(nil, config); !errors.Is(err, errNoPlayers) || !strings.Contains(err.Error(), "pool is empty") {
+		t.Errorf("noTargetsError() on an empty pool = %v", err)
+	}
+}
+
+// TestUnknownPositionNeverTarget checks that players with an unknown position are never drawn
+// as the mystery player, and that -exclude-unknown also takes them out of the guessable pool
+func TestUnknownPositionNeverTarget(t *testing.T) {
+	pool := getFallbackPlayers()
+	for i := range pool[:len(pool)/2] {
+		pool[i].Position = "Unknown"
+	}
+
+	for _, exclude := range []bool{false, true} {
+		config := defaultConfig()
+		config.ExcludeUnknown = exclude
+		config.Rand = rand.New(rand.NewSource(1))
+		usePlayers(t, filterPool(pool, config))
+		for i := 0; i < 200; i++ {
+			target, err := getRandomPlayer(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if target.Position == "Unknown" {
+				t.Fatalf("-exclude-unknown=%v drew %s, whose position is unknown", exclude, target.Name)
+			}
+		}
+		_, guessable := store.PlayerByName(normalizeName(pool[0].Name))
+		if guessable == exclude {
+			t.Errorf("-exclude-unknown=%v: %s guessable = %v", exclude, pool[0].Name, guessable)
+		}
+	}
+}
+
+// TestExcludedNeverTarget checks that players named with -exclude and -exclude-file are never
+// drawn as the mystery player but can still be guessed
+func TestExcludedNeverTarget(t *testing.T) {
+	pool := useFallbackPlayers(t)
+	excludeFile := filepath.Join(t.TempDir(), "exclude.txt")
+	var lines []string
+	for _, player := range pool[2 : len(pool)-1] {
+		lines = append(lines, player.Name)
+	}
+	if err := os.WriteFile(excludeFile, []byte("# everyone but three\n"+strings.Join(lines, "\n")+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	config, err := parseTestFlags(t, "-exclude", strings.ToLower(pool[0].Name), "-exclude-file", excludeFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.Rand = rand.New(rand.NewSource(1))
+
+	allowed := map[string]bool{pool[1].Name: true, pool[len(pool)-1].Name: true}
+	for i := 0; i < 200; i++ {
+		target, err := getRandomPlayer(config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !allowed[target.Name] {
+			t.Fatalf("drew %s, who was excluded", target.Name)
+		}
+	}
+	if _, found := findPlayerByName(pool[0].Name, config); !found {
+		t.Errorf("excluded %s can't be guessed", pool[0].Name)
+	}
+}
+
+// TestCheckDraftClass checks that empty and sparse draft classes are refused before a game starts
+func TestCheckDraftClass(t *testing.T) {
+	var pool []Player
+	for i := 0; i < minDraftClassSize; i++ {
+		pool = append(pool, Player{Name: fmt.Sprintf("Full Class%d", i), Position: "G", DraftYear: 2010})
+	}
+	for i := 0; i < minDraftClassSize-1; i++ {
+		pool = append(pool, Player{Name: fmt.Sprintf("Sparse Class%d", i), Position: "G", DraftYear: 2011})
+	}
+	pool = append(pool, Player{Name: "Unknown Position", Position: "Unknown", DraftYear: 2011})
+	usePlayers(t, pool)
+
+	tests := []struct {
+		class int
+		want  string // Start of the error, "" for none
+	}{
+		{0, ""},
+		{2010, ""},
+		{2011, "only 4 player(s) from the 2011 draft class"}, // The unknown position isn't eligible
+		{1950, "only 0 player(s) from the 1950 draft class"},
+	}
+	for _, test := range tests {
+		config := defaultConfig()
+		config.DraftClass = test.class
+		err := checkDraftClass(config)
+		switch {
+		case test.want == "" && err != nil:
+			t.Errorf("-draft-class=%d: unexpected error %v", test.class, err)
+		case test.want != "" && (err == nil || !strings.HasPrefix(err.Error(), test.want)):
+			t.Errorf("-draft-class=%d: error %v, want one starting %q", test.class, err, test.want)
+		}
+	}
+}
+
+// TestTwoWayExclusion checks that two-way players are skipped as mystery players unless
+// -include-twoway allows them, and stay guessable either way
+func TestTwoWayExclusion(t *testing.T) {
+	pool := getFallbackPlayers()
+	for i := range pool[1:] {
+		pool[i+1].ContractType = contractTwoWay
+	}
+	usePlayers(t, pool)
+
+	config := defaultConfig()
+	targets := randomTargets(config)
+	if len(targets) != 1 || targets[0].Name != pool[0].Name {
+		t.Errorf("targets without -include-twoway = %d players, want only %s", len(targets), pool[0].Name)
+	}
+	if _, found := findPlayerByName(pool[1].Name, config); !found {
+		t.Errorf("two-way %s can't be guessed", pool[1].Name)
+	}
+
+	config.IncludeTwoWay = true
+	if got := len(randomTargets(config)); got != len(pool) {
+		t.Errorf("targets with -include-twoway = %d players, want all %d", got, len(pool))
+	}
+}
+
+// TestCandidatesWinCondition checks a -candidates-file game: the mystery player is always one
+// of the candidates, guesses outside the list are refused for free, and naming the answer wins
+func TestCandidatesWinCondition(t *testing.T) {
+	pool := useFallbackPlayers(t)
+	file := filepath.Join(t.TempDir(), "candidates.json")
+	list := fmt.Sprintf("[%q, %q, %q]", pool[1].Name, strings.ToUpper(pool[2].Name), pool[3].Name)
+	if err := os.WriteFile(file, []byte(list), 0644); err != nil {
+		t.Fatal(err)
+	}
+	config, err := parseTestFlags(t, "-candidates-file", file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.Clock = newFakeClock()
+	config.Rand = rand.New(rand.NewSource(1))
+	config.SaveFile = filepath.Join(t.TempDir(), "save.json")
+
+	for i := 0; i < 50; i++ {
+		target, err := getRandomPlayer(config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !isCandidate(target, config) {
+			t.Fatalf("drew %s, who isn't a candidate", target.Name)
+		}
+	}
+
+	target := pool[2]
+	wrong := pool[1]
+	game := newGame(config, target)
+	outcome := playLines(game, pool[0].Name, pool[len(pool)-1].Name, wrong.Name, target.Name)
+	if outcome != OutcomeWon || game.Attempts != 2 {
+		t.Errorf("two outsiders, a wrong candidate, then the answer: %v in %d attempts, want a win in 2", outcome, game.Attempts)
+	}
+}
+
+// TestFitAttemptsToPool checks that a pool small enough to guess through lowers the attempt
+// limit with -small-pool=adjust, only warns with warn, and is left alone otherwise
+func TestFitAttemptsToPool(t *testing.T) {
+	players := getFallbackPlayers()
+	tests := []struct {
+		name         string
+		pool         int
+		change       func(*GameConfig)
+		wantAttempts int
+		wantMessage  string
+	}{
+		{"adjust", 5, nil, 4, "attempts are lowered from 8 to 4"},
+		{"adjust a pool of one", 1, nil, 1, "attempts are lowered from 8 to 1"},
+		{"adjust a pool as big as the limit", 8, nil, 7, "lowered from 8 to 7"},
+		{"pool bigger than the limit", 9, nil, 8, ""},
+		{"warn", 5, func(c *GameConfig) { c.SmallPool = "warn" }, 8, "Only 5 players can be guessed"},
+		{"off", 5, func(c *GameConfig) { c.SmallPool = "off" }, 8, ""},
+		{"unlimited attempts", 5, func(c *GameConfig) { c.UnlimitedAttempts = true }, 8, ""},
+		{"team mode", 5, func(c *GameConfig) { c.Mode = "team" }, 8, ""},
+		{"candidates", len(players), func(c *GameConfig) {
+			c.Candidates = []string{normalizeName(players[0].Name), normalizeName(players[1].Name), normalizeName(players[2].Name)}
+		}, 2, "Only 3 players can be guessed"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			usePlayers(t, players[:test.pool])
+			config := defaultConfig()
+			config.MaxAttempts = 8
+			if test.change != nil {
+				test.change(&config)
+			}
+			message := fitAttemptsToPool(&config)
+			if config.MaxAttempts != test.wantAttempts {
+				t.Errorf("attempts = %d, want %d", config.MaxAttempts, test.wantAttempts)
+			}
+			if (test.wantMessage == "") != (message == "") || !strings.Contains(message, test.wantMessage) {
+				t.Errorf("message = %q, want one containing %q", message, test.wantMessage)
+			}
+		})
+	}
+}
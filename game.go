@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"     // Package for formatted I/O operations
+	"sort"    // Package for sorting slices
 	"strings" // Package for string manipulation functions
 )
 
@@ -11,122 +12,465 @@ type ComparisonResult struct {
 	Team         string // Formatted team with color indicator
 	Position     string // Formatted position with color indicator
 	Height       string // Formatted height with color indicator
+	Weight       string // Formatted weight with color indicator
 	College      string // Formatted college with color indicator
 	DraftYear    string // Formatted draft year with color indicator
 	DraftRound   string // Formatted draft round with color indicator
 	DraftNumber  string // Formatted draft number with color indicator
 	JerseyNumber string // Formatted jersey number with color indicator
 	Country      string // Formatted country with color indicator
+
+	Statuses map[string]MatchStatus // Match status of each attribute, keyed by attribute name
+}
+
+// comparedAttributes lists every compared attribute in table column order
+var comparedAttributes = []string{"name", "team", "position", "height", "weight", "college", "draftyear", "draftround", "draftnumber", "jerseynumber", "country"}
+
+// tableColumnWidths holds the default width of each table column in comparedAttributes order
+var tableColumnWidths = []int{20, 20, 8, 7, 6, 15, 9, 5, 6, 6, 12}
+
+// fitColumn pads a value to the column width, or cuts it short with an ellipsis when it is
+// too long, measuring display width so emoji markers and multibyte names stay aligned
+func fitColumn(value string, width int) string {
+	if displayWidth(value) <= width {
+		return value + strings.Repeat(" ", width-displayWidth(value))
+	}
+	var cut strings.Builder
+	used := 0
+	for _, r := range value {
+		if used+runeWidth(r) > width-1 {
+			break // Leave one column for the ellipsis
+		}
+		cut.WriteRune(r)
+		used += runeWidth(r)
+	}
+	return cut.String() + "…" + strings.Repeat(" ", width-1-used) // A wide rune may leave a gap
+}
+
+// tableSeparators maps each -separator name to the text drawn between table columns
+var tableSeparators = map[string]string{
+	"bars":   " | ",
+	"spaces": "  ",
+}
+
+// separatorNames returns the -separator names in a fixed order for help text
+func separatorNames() []string {
+	return []string{"bars", "spaces"}
+}
+
+// tableStyle decides how the comparison table is drawn
+type tableStyle struct {
+	Separator string // Text between columns
+	Border    bool   // Whether the table is boxed, with a rule under every row
+	Widths    []int  // Width of each column in comparedAttributes order
+}
+
+// tableStyle returns the style the comparison table is drawn in under the configured
+// separator, border, and pick-gap setting
+func (c GameConfig) tableStyle() tableStyle {
+	style := tableStyle{Separator: tableSeparators[c.Separator], Border: c.Border}
+	if style.Separator == "" {
+		style.Separator = tableSeparators["bars"] // Unknown names are rejected by parseFlags
+	}
+
+	// Copy the defaults so widening a column never changes another config's table
+	style.Widths = append([]int(nil), tableColumnWidths...)
+	// Close picks shown with their gap need room for it, e.g. "🟡 12 ≤10"
+	if c.PickGap != "off" {
+		for i, attribute := range comparedAttributes {
+			if attribute == "draftnumber" {
+				style.Widths[i] = max(style.Widths[i], pickGapWidth)
+			}
+		}
+	}
+	return style
+}
+
+// pickGapWidth is the PICK column width when -pick-gap shows close picks' gaps
+const pickGapWidth = 9
+
+// tableWidth returns the width of a table row in the style, including any border
+func (s tableStyle) tableWidth() int {
+	width := len([]rune(s.Separator)) * (len(s.Widths) - 1)
+	for _, columnWidth := range s.Widths {
+		width += columnWidth
+	}
+	if s.Border {
+		width += 4 // "| " and " |"
+	}
+	return width
+}
+
+// rule returns a horizontal line as wide as the table, boxed at the corners when bordered
+func (s tableStyle) rule(fill string) string {
+	if !s.Border {
+		return strings.Repeat(fill, s.tableWidth())
+	}
+	return "+" + strings.Repeat(fill, s.tableWidth()-2) + "+"
 }
 
-// String method formats ComparisonResult for display in tabular format
-func (cr ComparisonResult) String() string {
+// formatRow lays out one cell per column, separated and bordered in the style
+func (s tableStyle) formatRow(cells ...string) string {
+	fitted := make([]string, len(cells))
+	for i, cell := range cells {
+		fitted[i] = fitColumn(cell, s.Widths[i])
+	}
+	row := strings.Join(fitted, s.Separator)
+	if s.Border {
+		row = "| " + row + " |"
+	}
+	return row
+}
+
+// tableString formats ComparisonResult for display in tabular format
+func (cr ComparisonResult) tableString(style tableStyle) string {
 	// Return formatted string with fixed-width columns for aligned display
-	return fmt.Sprintf("%-20s | %-20s | %-8s | %-6s | %-15s | %-9s | %-5s | %-6s | %-6s | %-12s",
-		cr.Name, cr.Team, cr.Position, cr.Height, cr.College, cr.DraftYear, cr.DraftRound, cr.DraftNumber, cr.JerseyNumber, cr.Country)
+	return style.formatRow(cr.Name, cr.Team, cr.Position, cr.Height, cr.Weight, cr.College, cr.DraftYear, cr.DraftRound, cr.DraftNumber, cr.JerseyNumber, cr.Country)
 }
 
-// compareWithTarget compares a guessed player with the target player and returns color-coded results
-func compareWithTarget(guess, target Player) ComparisonResult {
-	// Initialize empty result structure
-	result := ComparisonResult{}
+// attributeDisplayNames holds the readable name of each attribute for messages
+var attributeDisplayNames = map[string]string{
+	"name":         "name",
+	"team":         "team",
+	"position":     "position",
+	"height":       "height",
+	"weight":       "weight",
+	"college":      "college",
+	"draftyear":    "draft year",
+	"draftround":   "draft round",
+	"draftnumber":  "draft pick",
+	"jerseynumber": "jersey number",
+	"country":      "country",
+}
 
-	// Compare Name - exact match required for green
-	if guess.Name == target.Name {
-		result.Name = "🟢 " + guess.Name // Green circle for exact match
-	} else {
-		result.Name = "🔴 " + guess.Name // Red circle for no match
+// blindString formats a comparison in table layout with only the markers, keeping the
+// guessed name so the board can still be followed
+func (cr ComparisonResult) blindString(guess Player, theme Theme, style tableStyle) string {
+	cells := make([]string, len(comparedAttributes))
+	for i, attribute := range comparedAttributes {
+		cells[i] = theme.marker(cr.Statuses[attribute])
 	}
+	cells[0] = theme.mark(cr.Statuses["name"], guess.Name)
+	return style.formatRow(cells...)
+}
 
-	// Compare Team - exact match required for green
-	if guess.Team == target.Team {
-		result.Team = "🟢 " + guess.Team // Green circle for exact match
-	} else {
-		result.Team = "🔴 " + guess.Team // Red circle for no match
+// attributeDelta is one attribute whose marker changed between two consecutive guesses
+type attributeDelta struct {
+	Attribute string      // Attribute name
+	From      MatchStatus // Status in the previous guess
+	To        MatchStatus // Status in the latest guess
+}
+
+// matchRank orders statuses from furthest to closest, or returns false for unknown values
+func matchRank(status MatchStatus) (int, bool) {
+	switch status {
+	case MatchMiss:
+		return 0, true
+	case MatchClose:
+		return 1, true
+	case MatchExact:
+		return 2, true
 	}
+	return 0, false
+}
 
-	// Compare Position - exact match required for green
-	if guess.Position == target.Position {
-		result.Position = "🟢 " + guess.Position // Green circle for exact match
-	} else {
-		result.Position = "🔴 " + guess.Position // Red circle for no match
+// resultDeltas returns the attributes whose status changed from the previous comparison to the
+// current one, in table column order
+func resultDeltas(previous, current ComparisonResult) []attributeDelta {
+	var deltas []attributeDelta
+	for _, attribute := range comparedAttributes {
+		if attribute == "name" {
+			continue // Every wrong guess misses the name
+		}
+		if from, to := previous.Statuses[attribute], current.Statuses[attribute]; from != to {
+			deltas = append(deltas, attributeDelta{Attribute: attribute, From: from, To: to})
+		}
+	}
+	return deltas
+}
+
+// describeDeltas summarizes changed markers, e.g. "position 🔴→🟢 (closer), draft year 🟢→🟡 (further)"
+func describeDeltas(deltas []attributeDelta, theme Theme) string {
+	if len(deltas) == 0 {
+		return "no markers changed"
 	}
+	parts := make([]string, len(deltas))
+	for i, delta := range deltas {
+		parts[i] = fmt.Sprintf("%s %s→%s", attributeDisplayNames[delta.Attribute], theme.marker(delta.From), theme.marker(delta.To))
+		from, fromKnown := matchRank(delta.From)
+		to, toKnown := matchRank(delta.To)
+		if fromKnown && toKnown && to > from {
+			parts[i] += " (closer)"
+		} else if fromKnown && toKnown {
+			parts[i] += " (further)"
+		}
+	}
+	return strings.Join(parts, ", ")
+}
 
-	// Compare Height - exact match required for green
-	if guess.Height == target.Height {
-		result.Height = "🟢 " + guess.Height // Green circle for exact match
+// attributeLabels holds the short label for each attribute in compact output
+var attributeLabels = map[string]string{
+	"name":         "Name",
+	"team":         "Team",
+	"position":     "Pos",
+	"height":       "Ht",
+	"weight":       "Wt",
+	"college":      "Col",
+	"draftyear":    "Yr",
+	"draftround":   "Rd",
+	"draftnumber":  "Pk",
+	"jerseynumber": "#",
+	"country":      "Ctry",
+}
+
+// attributeValue returns a player's plain value for a compared attribute, without a marker
+func attributeValue(player Player, attribute string, config GameConfig) string {
+	switch attribute {
+	case "name":
+		return player.Name
+	case "team":
+		return comparedTeam(player, config)
+	case "position":
+		return player.Position
+	case "height":
+		return player.Height
+	case "weight":
+		return formatWeight(player.Weight)
+	case "college":
+		return player.College
+	case "draftyear":
+		return formatDraftYear(player.DraftYear)
+	case "draftround":
+		return fmt.Sprintf("%d", player.DraftRound)
+	case "draftnumber":
+		return fmt.Sprintf("%d", player.DraftNumber)
+	case "jerseynumber":
+		return player.JerseyNumber
+	case "country":
+		return player.Country
+	}
+	return ""
+}
+
+// compactAttributes returns the attributes of a compact line in display order. Priority order
+// puts the most informative first, ranked by the similarity weights (team and position lead,
+// country trails); ties and fixed order keep the wide table's column order
+func compactAttributes(config GameConfig) []string {
+	attributes := append([]string{}, comparedAttributes...)
+	if config.CompactOrder == "priority" {
+		sort.SliceStable(attributes, func(i, j int) bool {
+			return config.SimilarityWeights[attributes[i]] > config.SimilarityWeights[attributes[j]]
+		})
+	}
+	return attributes
+}
+
+// compactString renders a comparison as one dense line of labeled markers,
+// e.g. "Curry: Team🔴 Pos🟢 Col🔴 ..." for narrow screens
+func (cr ComparisonResult) compactString(guess Player, config GameConfig) string {
+	theme := config.theme()
+	// Use the last name to keep the line short
+	nameParts := strings.Fields(guess.Name)
+	shortName := guess.Name
+	if len(nameParts) > 1 {
+		shortName = nameParts[len(nameParts)-1]
+	}
+
+	tokens := []string{shortName + ":"}
+	for _, attribute := range compactAttributes(config) {
+		if attribute == "name" {
+			continue // The name is already shown at the start of the line
+		}
+		tokens = append(tokens, attributeLabels[attribute]+theme.marker(cr.Statuses[attribute]))
+	}
+	return strings.Join(tokens, " ")
+}
+
+// compareWithTarget compares a guessed player with the target player and returns results
+// marked with the configured theme
+func compareWithTarget(guess, target Player, config GameConfig) ComparisonResult {
+	theme := config.theme()
+
+	// Initialize empty result structure
+	result := ComparisonResult{Statuses: make(map[string]MatchStatus)}
+
+	// mark records an attribute's status and returns its marked display value
+	mark := func(attribute string, status MatchStatus, value string) string {
+		result.Statuses[attribute] = status
+		return theme.mark(status, value)
+	}
+
+	// Compare Name, Team, and Position - exact match required
+	result.Name = mark("name", exactStatus(guess.Name == target.Name), guess.Name)
+	result.Team = mark("team", teamStatus(comparedTeam(guess, config), comparedTeam(target, config)), comparedTeam(guess, config))
+	result.Position = mark("position", positionStatus(guess.Position, target.Position, config), guess.Position)
+
+	// Compare Height in inches with the configured tolerance
+	guessInches, guessOK := heightInches(guess.Height)
+	targetInches, targetOK := heightInches(target.Height)
+	if !guessOK || !targetOK {
+		// Fall back to comparing the text when either height can't be parsed
+		status := exactStatus(guess.Height == target.Height)
+		if status == MatchMiss {
+			status = MatchUnknown
+		}
+		result.Height = mark("height", status, guess.Height)
 	} else {
-		result.Height = "🔴 " + guess.Height // Red circle for no match
+		result.Height = mark("height", toleranceStatus(guessInches-targetInches, config.HeightToleranceInches), guess.Height)
 	}
 
-	// Compare College - exact match required for green
-	if guess.College == target.College {
-		result.College = "🟢 " + guess.College // Green circle for exact match
+	// Compare Weight in pounds with the configured tolerance
+	if guess.Weight == 0 || target.Weight == 0 {
+		// Missing weights can't be compared
+		result.Weight = mark("weight", MatchUnknown, formatWeight(guess.Weight))
 	} else {
-		result.College = "🔴 " + guess.College // Red circle for no match
+		result.Weight = mark("weight", toleranceStatus(guess.Weight-target.Weight, config.WeightToleranceLbs), formatWeight(guess.Weight))
 	}
 
+	// Compare College - exact match required
+	result.College = mark("college", exactStatus(guess.College == target.College), guess.College)
+
 	// Compare Draft Year with tolerance for close matches
-	if guess.DraftYear == target.DraftYear {
-		// Exact match gets green
-		result.DraftYear = "🟢 " + fmt.Sprintf("%d", guess.DraftYear)
-	} else if abs(guess.DraftYear-target.DraftYear) <= 2 {
-		// Within 2 years gets yellow (close match)
-		result.DraftYear = "🟡 " + fmt.Sprintf("%d", guess.DraftYear)
+	if guess.DraftYear == unknownDraftYear || target.DraftYear == unknownDraftYear {
+		// An unknown year on either side can't be compared
+		result.DraftYear = mark("draftyear", MatchUnknown, formatDraftYear(guess.DraftYear))
 	} else {
-		// More than 2 years difference gets red
-		result.DraftYear = "🔴 " + fmt.Sprintf("%d", guess.DraftYear)
+		// Within the configured number of years is a close match
+		result.DraftYear = mark("draftyear", toleranceStatus(guess.DraftYear-target.DraftYear, config.DraftYearTolerance), formatDraftYear(guess.DraftYear))
 	}
 
-	// Compare Draft Round - exact match required for green
-	if guess.DraftRound == target.DraftRound {
-		if guess.DraftRound == 0 {
-			result.DraftRound = "🟢 Undrafted" // Special case for undrafted players
-		} else {
-			result.DraftRound = "🟢 " + fmt.Sprintf("%d", guess.DraftRound)
-		}
-	} else {
-		if guess.DraftRound == 0 {
-			result.DraftRound = "🔴 Undrafted" // Special case for undrafted players
-		} else {
-			result.DraftRound = "🔴 " + fmt.Sprintf("%d", guess.DraftRound)
-		}
+	// Compare Draft Round - exact match required
+	draftRound := fmt.Sprintf("%d", guess.DraftRound)
+	if guess.DraftRound == 0 {
+		draftRound = "Undrafted" // Special case for undrafted players
 	}
+	result.DraftRound = mark("draftround", exactStatus(guess.DraftRound == target.DraftRound), draftRound)
 
 	// Compare Draft Number with tolerance for close matches
+	draftNumber := fmt.Sprintf("%d", guess.DraftNumber)
+	if guess.DraftNumber == 0 {
+		draftNumber = "N/A" // Special case for undrafted players
+	}
 	if guess.DraftNumber == target.DraftNumber {
-		if guess.DraftNumber == 0 {
-			result.DraftNumber = "🟢 N/A" // Special case for undrafted players
-		} else {
-			result.DraftNumber = "🟢 " + fmt.Sprintf("%d", guess.DraftNumber)
-		}
-	} else if guess.DraftNumber != 0 && target.DraftNumber != 0 && abs(guess.DraftNumber-target.DraftNumber) <= 5 {
-		// Within 5 picks gets yellow (close match) - only for drafted players
-		result.DraftNumber = "🟡 " + fmt.Sprintf("%d", guess.DraftNumber)
+		result.DraftNumber = mark("draftnumber", MatchExact, draftNumber)
+	} else if guess.DraftNumber != 0 && target.DraftNumber != 0 && abs(guess.DraftNumber-target.DraftNumber) <= config.DraftPickTolerance {
+		// Within the configured number of picks is a close match - only for drafted players
+		result.DraftNumber = mark("draftnumber", MatchClose, draftNumber+pickGap(target.DraftNumber-guess.DraftNumber, config))
+	} else if draftTier(guess.DraftNumber) == draftTier(target.DraftNumber) {
+		// Same pick tier (e.g. both lottery picks) is also a close match
+		result.DraftNumber = mark("draftnumber", MatchClose, draftNumber)
 	} else {
-		if guess.DraftNumber == 0 {
-			result.DraftNumber = "🔴 N/A" // Special case for undrafted players
-		} else {
-			result.DraftNumber = "🔴 " + fmt.Sprintf("%d", guess.DraftNumber)
+		result.DraftNumber = mark("draftnumber", MatchMiss, draftNumber)
+	}
+
+	// Compare Jersey Number and Country - exact match required
+	result.JerseyNumber = mark("jerseynumber", exactStatus(guess.JerseyNumber == target.JerseyNumber), guess.JerseyNumber)
+	result.Country = mark("country", exactStatus(guess.Country == target.Country), guess.Country)
+
+	// Return the complete comparison result
+	return result
+}
+
+// pickGapBuckets are the "within N picks" steps -pick-gap=bucket rounds a close pick's gap up to;
+// gaps past the last step fall in the tolerance's own bucket
+var pickGapBuckets = []int{2, 5}
+
+// pickGap returns how a close draft pick's gap to the mystery player's pick is shown after the
+// guessed pick: nothing (off), the signed gap ("+3", the mystery player went 3 picks later),
+// an arrow toward the mystery pick ("↑" for a later, higher-numbered pick), or a bucket ("≤5")
+func pickGap(gap int, config GameConfig) string {
+	switch config.PickGap {
+	case "number":
+		return fmt.Sprintf(" %+d", gap)
+	case "arrow":
+		if gap > 0 {
+			return " ↑"
 		}
+		return " ↓"
+	case "bucket":
+		for _, bucket := range pickGapBuckets {
+			if abs(gap) <= bucket && bucket < config.DraftPickTolerance {
+				return fmt.Sprintf(" ≤%d", bucket)
+			}
+		}
+		return fmt.Sprintf(" ≤%d", config.DraftPickTolerance)
 	}
+	return ""
+}
 
-	// Compare Jersey Number - exact match required for green
-	if guess.JerseyNumber == target.JerseyNumber {
-		result.JerseyNumber = "🟢 " + guess.JerseyNumber // Green circle for exact match
-	} else {
-		result.JerseyNumber = "🔴 " + guess.JerseyNumber // Red circle for no match
+// matchScore rates how close a guess was: 2 points per exact attribute and 1 per close attribute
+func (cr ComparisonResult) matchScore() int {
+	score := 0
+	for _, status := range cr.Statuses {
+		switch status {
+		case MatchExact:
+			score += 2
+		case MatchClose:
+			score++
+		}
+	}
+	return score
+}
+
+// toleranceStatus rates a numeric difference: exact at 0, close within the tolerance, otherwise a miss
+func toleranceStatus(difference, tolerance int) MatchStatus {
+	switch {
+	case difference == 0:
+		return MatchExact
+	case abs(difference) <= tolerance:
+		return MatchClose
+	default:
+		return MatchMiss
 	}
+}
 
-	// Compare Country - exact match required for green
-	if guess.Country == target.Country {
-		result.Country = "🟢 " + guess.Country // Green circle for exact match
-	} else {
-		result.Country = "🔴 " + guess.Country // Red circle for no match
+// exactStatus converts an exact-match check into a match status
+func exactStatus(matches bool) MatchStatus {
+	if matches {
+		return MatchExact
 	}
+	return MatchMiss
+}
 
-	// Return the complete comparison result
-	return result
+// genericPositions maps the generic positions some API records use to the specific positions they cover
+var genericPositions = map[string][]string{
+	"G": {"PG", "SG"},
+	"F": {"SF", "PF"},
+}
+
+// positionStatus compares two positions; a generic guard or forward is a close match for the
+// specific positions it covers (G with PG/SG, F with SF/PF) unless -strict-positions is set
+func positionStatus(guessPosition, targetPosition string, config GameConfig) MatchStatus {
+	if guessPosition == targetPosition {
+		return MatchExact
+	}
+	if config.StrictPositions {
+		return MatchMiss
+	}
+	for generic, specifics := range genericPositions {
+		for _, specific := range specifics {
+			if (guessPosition == generic && targetPosition == specific) || (guessPosition == specific && targetPosition == generic) {
+				return MatchClose
+			}
+		}
+	}
+	return MatchMiss
+}
+
+// teamStatus compares two teams; two free agents don't share a team, so that pair can't be compared
+func teamStatus(guessTeam, targetTeam string) MatchStatus {
+	if guessTeam == freeAgentTeam && targetTeam == freeAgentTeam {
+		return MatchUnknown
+	}
+	return exactStatus(guessTeam == targetTeam)
+}
+
+// formatDraftYear returns the draft year for display, or "Unknown" for the sentinel value
+func formatDraftYear(year int) string {
+	if year == unknownDraftYear {
+		return "Unknown"
+	}
+	return fmt.Sprintf("%d", year)
 }
 
 // abs returns the absolute value of an integer
@@ -138,32 +482,36 @@ func abs(x int) int {
 }
 
 // printHeader displays the column headers for the comparison results table
-func printHeader() {
-	// Print separator line of equal signs
-	fmt.Println(strings.Repeat("=", 150))
+func printHeader(config GameConfig) {
+	style := config.tableStyle()
+	// Print separator line of equal signs, as wide as the table
+	fmt.Println(style.rule("="))
 
 	// Print column headers with fixed widths for alignment
-	fmt.Printf("%-20s | %-20s | %-8s | %-6s | %-15s | %-9s | %-5s | %-6s | %-6s | %-12s\n",
-		"NAME", "TEAM", "POSITION", "HEIGHT", "COLLEGE", "DRAFT YR", "ROUND", "PICK", "JERSEY", "COUNTRY")
+	fmt.Println(style.formatRow("NAME", "TEAM", "POSITION", "HEIGHT", "WEIGHT", "COLLEGE", "DRAFT YR", "ROUND", "PICK", "JERSEY", "COUNTRY"))
 
 	// Print another separator line
-	fmt.Println(strings.Repeat("=", 150))
+	fmt.Println(style.rule("="))
 }
 
 // printInstructions displays the game rules and setup information
-func printInstructions() {
+func printInstructions(config GameConfig) {
 	// Print game rules and instructions
 	fmt.Println("\nHow to play:")
 	fmt.Println("- Guess NBA players by typing their full name")
-	fmt.Println("- You have 8 attempts and 6 minutes to guess correctly")
-	fmt.Println("- 🟢 Green = Exact match")
-	fmt.Println("- 🟡 Yellow = Close match (within range for numbers)")
-	fmt.Println("- 🔴 Red = No match")
+	fmt.Printf("- You have %s to guess correctly\n", config.limitsDescription())
+	theme := config.theme()
+	fmt.Printf("- %s = Exact match\n", theme.Exact)
+	fmt.Printf("- %s = Close match (within range for numbers)\n", theme.Close)
+	fmt.Printf("- %s = No match\n", theme.Miss)
+	fmt.Printf("- %s = Can't be compared (data not available)\n", theme.Unknown)
 
 	// Display information about the player database size
-	fmt.Printf("\nDatabase contains %d NBA players from throughout history!\n", len(players))
-	fmt.Println("Type 'hint' during the game to get clues about the mystery player (limited to 3 hints).")
-	fmt.Println("⏰ Race against time - you only have 6 minutes!")
+	fmt.Printf("\nDatabase contains %s NBA players!\n", formatCount(len(store.Players())))
+	fmt.Printf("Type 'hint' during the game to get clues about the mystery player (limited to %d hints).\n", config.MaxHints)
+	if !config.NoTimeLimit {
+		fmt.Printf("⏰ Race against time - you only have %s!\n", formatTimeRemaining(config.TimeLimit))
+	}
 
 	// Print decorative separator line
 	fmt.Println(strings.Repeat("=", 80))
@@ -0,0 +1,470 @@
+package main
+
+import (
+	"fmt"          // Package for formatted I/O operations
+	"maps"         // Package for copying the weight map
+	"slices"       // Package for comparing delta lists
+	"strings"      // Package for string manipulation functions
+	"testing"      // Package for Go tests
+	"unicode/utf8" // Package for checking cut strings are valid UTF-8
+)
+
+// TestTableStyle checks that each config gets its own column widths and separator
+func TestTableStyle(t *testing.T) {
+	plain := defaultConfig()
+	plain.PickGap = "off"
+	gaps := defaultConfig()
+	gaps.PickGap = "number"
+	gaps.Separator = "spaces"
+	gaps.Border = true
+
+	pick := len(comparedAttributes) - 3 // The draftnumber column
+	if comparedAttributes[pick] != "draftnumber" {
+		t.Fatalf("column %d is %q, not draftnumber", pick, comparedAttributes[pick])
+	}
+	wide := gaps.tableStyle()
+	narrow := plain.tableStyle()
+	if wide.Widths[pick] != pickGapWidth {
+		t.Errorf("pick-gap column width = %d, want %d", wide.Widths[pick], pickGapWidth)
+	}
+	if narrow.Widths[pick] != tableColumnWidths[pick] {
+		t.Errorf("widening one config's table changed another's: %d, want %d", narrow.Widths[pick], tableColumnWidths[pick])
+	}
+	if wide.Separator != "  " || narrow.Separator != " | " {
+		t.Errorf("separators = %q and %q", wide.Separator, narrow.Separator)
+	}
+	if got, want := displayWidth(wide.rule("=")), wide.tableWidth(); got != want {
+		t.Errorf("bordered rule is %d wide, want %d", got, want)
+	}
+	row := narrow.formatRow("NAME", "TEAM", "POSITION", "HEIGHT", "WEIGHT", "COLLEGE", "DRAFT YR", "ROUND", "PICK", "JERSEY", "COUNTRY")
+	if got, want := displayWidth(row), narrow.tableWidth(); got != want {
+		t.Errorf("row is %d wide, want %d", got, want)
+	}
+}
+
+// comparePlayers returns a guess and a target that differ from the first fallback player only
+// where the test changes them
+func comparePlayers(guess, target func(*Player)) (Player, Player) {
+	base := getFallbackPlayers()[0]
+	g, tg := base, base
+	g.Name = "Guess Player"
+	if guess != nil {
+		guess(&g)
+	}
+	if target != nil {
+		target(&tg)
+	}
+	return g, tg
+}
+
+// TestCompareUnknownDraftYear checks that the unknown-year sentinel is never compared as a year
+func TestCompareUnknownDraftYear(t *testing.T) {
+	config := defaultConfig()
+	tests := []struct {
+		name          string
+		guess, target int
+		want          MatchStatus
+		wantText      string
+	}{
+		{"both known", 2003, 2003, MatchExact, "2003"},
+		{"within tolerance", 2004, 2003, MatchClose, "2004"},
+		{"guess unknown", unknownDraftYear, 2003, MatchUnknown, "Unknown"},
+		{"target unknown", 2003, unknownDraftYear, MatchUnknown, "2003"},
+		{"both unknown", unknownDraftYear, unknownDraftYear, MatchUnknown, "Unknown"},
+	}
+	for _, test := range tests {
+		guess, target := comparePlayers(func(p *Player) { p.DraftYear = test.guess }, func(p *Player) { p.DraftYear = test.target })
+		result := compareWithTarget(guess, target, config)
+		if got := result.Statuses["draftyear"]; got != test.want {
+			t.Errorf("%s: status %v, want %v", test.name, got, test.want)
+		}
+		if !strings.Contains(result.DraftYear, test.wantText) {
+			t.Errorf("%s: cell %q doesn't show %q", test.name, result.DraftYear, test.wantText)
+		}
+	}
+}
+
+// TestDifficultyTolerances checks that each difficulty's height and weight tolerances decide
+// where close ends
+func TestDifficultyTolerances(t *testing.T) {
+	tests := []struct {
+		difficulty string
+		attribute  string
+		off        int // Inches or pounds the guess is away from the mystery player
+		want       MatchStatus
+	}{
+		{"easy", "height", 2, MatchClose},
+		{"easy", "height", 3, MatchMiss},
+		{"normal", "height", 1, MatchClose},
+		{"normal", "height", 2, MatchMiss},
+		{"hard", "height", 0, MatchExact},
+		{"hard", "height", 1, MatchMiss},
+		{"easy", "weight", 15, MatchClose},
+		{"easy", "weight", 16, MatchMiss},
+		{"normal", "weight", 10, MatchClose},
+		{"normal", "weight", 11, MatchMiss},
+		{"hard", "weight", 5, MatchClose},
+		{"hard", "weight", 6, MatchMiss},
+	}
+	for _, test := range tests {
+		config := defaultConfig()
+		if err := config.applyDifficulty(test.difficulty); err != nil {
+			t.Fatal(err)
+		}
+		guess, target := comparePlayers(func(p *Player) {
+			p.Height, p.Weight = "6'0\"", 220
+			if test.attribute == "height" {
+				p.Height = fmt.Sprintf("6'%d\"", test.off)
+			} else {
+				p.Weight += test.off
+			}
+		}, func(p *Player) {
+			p.Height, p.Weight = "6'0\"", 220
+		})
+		result := compareWithTarget(guess, target, config)
+		if got := result.Statuses[test.attribute]; got != test.want {
+			t.Errorf("%s: %s off by %d = %v, want %v", test.difficulty, test.attribute, test.off, got, test.want)
+		}
+	}
+}
+
+// TestCustomTolerances checks that tolerances set apart from the preset are the ones applied
+func TestCustomTolerances(t *testing.T) {
+	config := defaultConfig()
+	config.HeightToleranceInches = 4
+	config.WeightToleranceLbs = 0
+	guess, target := comparePlayers(func(p *Player) {
+		p.Height = "6'10\""
+		p.Weight = 221
+	}, func(p *Player) {
+		p.Height = "6'6\""
+		p.Weight = 220
+	})
+	result := compareWithTarget(guess, target, config)
+	if got := result.Statuses["height"]; got != MatchClose {
+		t.Errorf("height 4 inches off with a 4-inch tolerance = %v, want close", got)
+	}
+	if got := result.Statuses["weight"]; got != MatchMiss {
+		t.Errorf("weight 1 pound off with no tolerance = %v, want a miss", got)
+	}
+}
+
+// TestCompareDraftTiers checks that picks outside the tolerance are still close in the same tier
+func TestCompareDraftTiers(t *testing.T) {
+	config := defaultConfig()
+	config.DraftPickTolerance = 2
+	tests := []struct {
+		name          string
+		guess, target int
+		want          MatchStatus
+	}{
+		{"within tolerance", 15, 17, MatchClose},
+		{"same tier", 1, 14, MatchClose},
+		{"across the lottery line", 14, 17, MatchMiss},
+		{"both undrafted", 0, 0, MatchExact},
+		{"undrafted against a pick", 0, 2, MatchMiss},
+		{"second round", 31, 60, MatchClose},
+	}
+	for _, test := range tests {
+		guess, target := comparePlayers(func(p *Player) { p.DraftNumber = test.guess }, func(p *Player) { p.DraftNumber = test.target })
+		if got := compareWithTarget(guess, target, config).Statuses["draftnumber"]; got != test.want {
+			t.Errorf("%s: picks %d and %d = %v, want %v", test.name, test.guess, test.target, got, test.want)
+		}
+	}
+}
+
+// TestTeamMode checks that -team-mode switches which team is compared, falling back to the
+// current team for players without an iconic one
+func TestTeamMode(t *testing.T) {
+	guess, target := comparePlayers(func(p *Player) {
+		p.Team, p.IconicTeam = "Los Angeles Lakers", "Cleveland Cavaliers"
+	}, func(p *Player) {
+		p.Team, p.IconicTeam = "Golden State Warriors", "Cleveland Cavaliers"
+	})
+	noIconic, _ := comparePlayers(func(p *Player) { p.Team, p.IconicTeam = "Golden State Warriors", "" }, nil)
+
+	tests := []struct {
+		mode  string
+		guess Player
+		want  MatchStatus
+		shown string
+	}{
+		{"current", guess, MatchMiss, "Los Angeles Lakers"},
+		{"iconic", guess, MatchExact, "Cleveland Cavaliers"},
+		{"iconic", noIconic, MatchMiss, "Golden State Warriors"},
+	}
+	for _, test := range tests {
+		config := defaultConfig()
+		config.TeamMode = test.mode
+		result := compareWithTarget(test.guess, target, config)
+		if got := result.Statuses["team"]; got != test.want {
+			t.Errorf("%s mode, %s: team %v, want %v", test.mode, test.guess.Team, got, test.want)
+		}
+		if !strings.Contains(result.Team, test.shown) {
+			t.Errorf("%s mode: team cell %q doesn't show %q", test.mode, result.Team, test.shown)
+		}
+	}
+}
+
+// TestCompactString checks the compact line for a known comparison, in both attribute orders
+func TestCompactString(t *testing.T) {
+	guess, target := comparePlayers(func(p *Player) {
+		p.Name, p.Team, p.Height, p.Weight = "Test Guesser", "Boston Celtics", "6'6\"", 230
+	}, func(p *Player) {
+		p.Team, p.Height, p.Weight = "Denver Nuggets", "6'7\"", 200
+	})
+	config := defaultConfig()
+	config.CompactOrder = "fixed"
+	result := compareWithTarget(guess, target, config)
+	want := "Guesser: Team🔴 Pos🟢 Ht🟡 Wt🔴 Col🟢 Yr🟢 Rd🟢 Pk🟢 #🟢 Ctry🟢"
+	if got := result.compactString(guess, config); got != want {
+		t.Errorf("compact line = %q, want %q", got, want)
+	}
+
+	config.CompactOrder = "priority"
+	got := result.compactString(guess, config)
+	if !strings.HasPrefix(got, "Guesser: Team🔴 Pos🟢") || !strings.HasSuffix(got, "Ctry🟢") {
+		t.Errorf("priority compact line = %q, want team and position first and country last", got)
+	}
+}
+
+// TestFitColumn checks padding and ellipsis truncation for plain, over-long, and multibyte values,
+// measured in display columns rather than bytes
+func TestFitColumn(t *testing.T) {
+	tests := []struct {
+		value string
+		width int
+		want  string
+	}{
+		{"Duke", 6, "Duke  "},
+		{"Kentucky", 8, "Kentucky"},
+		{"North Carolina State", 10, "North Car…"},
+		{"Nikola Jokić", 12, "Nikola Jokić"},
+		{"Nikola Jokić", 9, "Nikola J…"},
+		{"Peñarol Montevideo", 8, "Peñarol…"},
+		{"🟢 Golden State Warriors", 10, "🟢 Golden…"},
+		{"🟢🟢🟢", 4, "🟢… "}, // The second marker doesn't fit beside the ellipsis
+	}
+	for _, test := range tests {
+		got := fitColumn(test.value, test.width)
+		if got != test.want {
+			t.Errorf("fitColumn(%q, %d) = %q, want %q", test.value, test.width, got, test.want)
+		}
+		if displayWidth(got) != test.width {
+			t.Errorf("fitColumn(%q, %d) is %d columns wide", test.value, test.width, displayWidth(got))
+		}
+		if !utf8.ValidString(got) {
+			t.Errorf("fitColumn(%q, %d) cut a rune in half: %q", test.value, test.width, got)
+		}
+	}
+}
+
+// TestBlindStringOmitsValues checks that a blind row shows the markers and the guessed name
+// but none of the guessed player's values
+func TestBlindStringOmitsValues(t *testing.T) {
+	guess, target := comparePlayers(func(p *Player) {
+		p.Team, p.College, p.Country, p.JerseyNumber = "Boston Celtics", "Villanova", "Canada", "77"
+	}, nil)
+	config := defaultConfig()
+	theme := config.theme()
+	result := compareWithTarget(guess, target, config)
+	row := result.blindString(guess, theme, config.tableStyle())
+
+	if !strings.Contains(row, guess.Name) {
+		t.Errorf("blind row %q doesn't name the guess", row)
+	}
+	for _, attribute := range comparedAttributes[1:] {
+		if value := attributeValue(guess, attribute, config); strings.Contains(row, value) {
+			t.Errorf("blind row %q shows the %s %q", row, attribute, value)
+		}
+	}
+	if got := strings.Count(row, theme.Miss); got != 5 {
+		t.Errorf("blind row %q has %d miss markers, want 5 (the name and four values)", row, got)
+	}
+	if got := strings.Count(row, theme.Exact); got != len(comparedAttributes)-5 {
+		t.Errorf("blind row %q has %d exact markers, want %d", row, got, len(comparedAttributes)-5)
+	}
+}
+
+// TestResultDeltas checks which markers count as changed between two guesses and how the
+// changes are described
+func TestResultDeltas(t *testing.T) {
+	result := func(statuses map[string]MatchStatus) ComparisonResult {
+		all := make(map[string]MatchStatus)
+		for _, attribute := range comparedAttributes {
+			all[attribute] = MatchMiss
+		}
+		for attribute, status := range statuses {
+			all[attribute] = status
+		}
+		return ComparisonResult{Statuses: all}
+	}
+	previous := result(map[string]MatchStatus{"team": MatchExact, "height": MatchClose, "draftyear": MatchUnknown})
+	current := result(map[string]MatchStatus{"team": MatchExact, "position": MatchExact, "height": MatchMiss, "college": MatchClose, "draftyear": MatchExact})
+
+	want := []attributeDelta{
+		{"position", MatchMiss, MatchExact},
+		{"height", MatchClose, MatchMiss},
+		{"college", MatchMiss, MatchClose},
+		{"draftyear", MatchUnknown, MatchExact},
+	}
+	got := resultDeltas(previous, current)
+	if !slices.Equal(got, want) {
+		t.Fatalf("resultDeltas = %+v, want %+v", got, want)
+	}
+	wantText := "position 🔴→🟢 (closer), height 🟡→🔴 (further), college 🔴→🟡 (closer), draft year ⚪→🟢"
+	if text := describeDeltas(got, themes["default"]); text != wantText {
+		t.Errorf("describeDeltas = %q, want %q", text, wantText)
+	}
+
+	if deltas := resultDeltas(current, current); len(deltas) != 0 {
+		t.Errorf("resultDeltas of identical results = %+v, want none", deltas)
+	}
+	if text := describeDeltas(nil, themes["default"]); text != "no markers changed" {
+		t.Errorf("describeDeltas(nil) = %q", text)
+	}
+	// The name marker changes only on the winning guess and is never listed
+	named := result(map[string]MatchStatus{"name": MatchExact})
+	if deltas := resultDeltas(previous, named); slices.ContainsFunc(deltas, func(d attributeDelta) bool { return d.Attribute == "name" }) {
+		t.Errorf("resultDeltas reported a name change: %+v", deltas)
+	}
+}
+
+// TestPositionStatus checks generic positions against specific ones, both ways round, with and
+// without -strict-positions
+func TestPositionStatus(t *testing.T) {
+	tests := []struct {
+		guess, target string
+		loose, strict MatchStatus
+	}{
+		{"PG", "PG", MatchExact, MatchExact},
+		{"G", "G", MatchExact, MatchExact},
+		{"G", "PG", MatchClose, MatchMiss},
+		{"G", "SG", MatchClose, MatchMiss},
+		{"SG", "G", MatchClose, MatchMiss},
+		{"F", "SF", MatchClose, MatchMiss},
+		{"PF", "F", MatchClose, MatchMiss},
+		{"G", "SF", MatchMiss, MatchMiss},
+		{"F", "PG", MatchMiss, MatchMiss},
+		{"G", "F", MatchMiss, MatchMiss},
+		{"F", "C", MatchMiss, MatchMiss},
+		{"PG", "SG", MatchMiss, MatchMiss}, // Two specific guards aren't close
+	}
+	config := defaultConfig()
+	strict := defaultConfig()
+	strict.StrictPositions = true
+	for _, test := range tests {
+		if got := positionStatus(test.guess, test.target, config); got != test.loose {
+			t.Errorf("positionStatus(%s, %s) = %v, want %v", test.guess, test.target, got, test.loose)
+		}
+		if got := positionStatus(test.guess, test.target, strict); got != test.strict {
+			t.Errorf("with -strict-positions, positionStatus(%s, %s) = %v, want %v", test.guess, test.target, got, test.strict)
+		}
+	}
+
+	guess, target := comparePlayers(func(p *Player) { p.Position = "F" }, func(p *Player) { p.Position = "SF" })
+	if got := compareWithTarget(guess, target, config).Statuses["position"]; got != MatchClose {
+		t.Errorf("comparing an F guess with an SF target marks the position %v, want close", got)
+	}
+}
+
+// TestRenderWithSeparators checks that a guess row is drawn with the chosen separator between
+// every column, boxed when bordered, and exactly as wide as the table in every style
+func TestRenderWithSeparators(t *testing.T) {
+	tests := []struct {
+		separator string
+		border    bool
+	}{
+		{"bars", false},
+		{"spaces", false},
+		{"bars", true},
+		{"spaces", true},
+	}
+	for _, test := range tests {
+		game, _ := newTestGame(t, func(config *GameConfig) {
+			config.Separator, config.Border, config.PickGap = test.separator, test.border, "off"
+		})
+		game.recordGuess(testGuess(game))
+		style := game.Config.tableStyle()
+		lines := strings.Split(game.renderRecord(game.History[0]), "\n")
+
+		row := lines[0]
+		if displayWidth(row) != style.tableWidth() {
+			t.Errorf("%s, border %v: row is %d wide, want %d:\n%s", test.separator, test.border, displayWidth(row), style.tableWidth(), row)
+		}
+		if test.border {
+			if !strings.HasPrefix(row, "| ") || !strings.HasSuffix(row, " |") {
+				t.Errorf("%s, border %v: row isn't boxed: %q", test.separator, test.border, row)
+			}
+			if len(lines) != 2 || lines[1] != style.rule("-") {
+				t.Errorf("%s, border %v: row isn't closed by a rule: %q", test.separator, test.border, lines[1:])
+			}
+			row = row[2 : len(row)-2]
+		} else if len(lines) != 1 {
+			t.Errorf("%s, border %v: got %d lines, want 1", test.separator, test.border, len(lines))
+		}
+		if got := strings.Count(row, tableSeparators[test.separator]); got < len(comparedAttributes)-1 {
+			t.Errorf("%s, border %v: %d separators in %q, want at least %d", test.separator, test.border, got, row, len(comparedAttributes)-1)
+		}
+		if test.separator == "spaces" && strings.Contains(row, "|") {
+			t.Errorf("spaces, border %v: row still has bars: %q", test.border, row)
+		}
+	}
+}
+
+// TestCompactPriorityOrder checks that compact lines list attributes by the configured weights,
+// heaviest first with ties in column order, and in column order with -compact-order=fixed
+func TestCompactPriorityOrder(t *testing.T) {
+	guess, target := comparePlayers(nil, nil)
+	custom := maps.Clone(defaultSimilarityWeights)
+	custom["country"], custom["jerseynumber"] = 9, 4
+
+	tests := []struct {
+		name    string
+		order   string
+		weights map[string]int
+		want    string
+	}{
+		{"default weights", "priority", defaultSimilarityWeights, "Player: Team🟢 Pos🟢 Col🟢 Ht🟢 Yr🟢 Wt🟢 Rd🟢 Pk🟢 #🟢 Ctry🟢"},
+		{"custom weights", "priority", custom, "Player: Ctry🟢 Team🟢 #🟢 Pos🟢 Col🟢 Ht🟢 Yr🟢 Wt🟢 Rd🟢 Pk🟢"},
+		{"fixed", "fixed", custom, "Player: Team🟢 Pos🟢 Ht🟢 Wt🟢 Col🟢 Yr🟢 Rd🟢 Pk🟢 #🟢 Ctry🟢"},
+	}
+	for _, test := range tests {
+		config := defaultConfig()
+		config.CompactOrder, config.SimilarityWeights = test.order, test.weights
+		if got := compareWithTarget(guess, target, config).compactString(guess, config); got != test.want {
+			t.Errorf("%s:\n got %s\nwant %s", test.name, got, test.want)
+		}
+	}
+}
+
+// TestPickGapStyles checks how each -pick-gap style shows a close draft pick, and that exact
+// picks and picks close only by tier never show a gap
+func TestPickGapStyles(t *testing.T) {
+	tests := []struct {
+		guess, target int
+		style         string
+		want          string
+	}{
+		{12, 9, "off", "🟡 12"},
+		{12, 9, "number", "🟡 12 -3"},
+		{9, 12, "number", "🟡 9 +3"},
+		{12, 9, "arrow", "🟡 12 ↓"},
+		{9, 12, "arrow", "🟡 9 ↑"},
+		{10, 9, "bucket", "🟡 10 ≤2"},
+		{12, 9, "bucket", "🟡 12 ≤5"},
+		{14, 9, "bucket", "🟡 14 ≤5"},
+		{9, 9, "number", "🟢 9"},
+		{3, 12, "number", "🟡 3"}, // Both lottery picks, 9 apart: close by tier only
+		{40, 9, "number", "🔴 40"},
+	}
+	for _, test := range tests {
+		guess, target := comparePlayers(func(p *Player) { p.DraftNumber = test.guess }, func(p *Player) { p.DraftNumber = test.target })
+		config := defaultConfig()
+		config.PickGap = test.style
+		config.DraftPickTolerance = 5
+		if got := compareWithTarget(guess, target, config).DraftNumber; got != test.want {
+			t.Errorf("pick %d against %d with -pick-gap=%s: %q, want %q", test.guess, test.target, test.style, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"encoding/json" // Package for JSON encoding and decoding
+	"fmt"           // Package for formatted I/O operations
+	"os"            // Package for file operations
+	"path/filepath" // Package for building file paths
+	"sort"          // Package for sorting player names
+	"time"          // Package for time-related operations
+)
+
+// H2HResult records how one player did on one seeded puzzle
+type H2HResult struct {
+	Won      bool          `json:"won"`      // Whether the mystery player was guessed
+	Attempts int           `json:"attempts"` // Guesses used
+	Elapsed  time.Duration `json:"elapsed"`  // Time taken, in nanoseconds
+}
+
+// HeadToHead is the shared record of seeded puzzles played by several people
+type HeadToHead struct {
+	Puzzles map[string]map[string]H2HResult `json:"puzzles"` // Results keyed by puzzle, then by player name
+}
+
+// H2HTally is one pairing's record over the puzzles both players finished
+type H2HTally struct {
+	Wins, Losses, Ties int
+}
+
+// defaultH2HFile returns ~/.hoop-detective/h2h.json, or a local file if the home directory is unknown
+func defaultH2HFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ".hoop-detective-h2h.json"
+	}
+	return filepath.Join(home, ".hoop-detective", "h2h.json")
+}
+
+// loadHeadToHead reads the head-to-head file, returning an empty record if it doesn't exist yet
+func loadHeadToHead(path string) (*HeadToHead, error) {
+	h2h := &HeadToHead{Puzzles: make(map[string]map[string]H2HResult)}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return h2h, nil // Nobody has played yet
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read head-to-head file: %v", err)
+	}
+	if err := json.Unmarshal(data, h2h); err != nil {
+		return nil, fmt.Errorf("failed to parse head-to-head file %s: %v", path, err)
+	}
+	if h2h.Puzzles == nil {
+		h2h.Puzzles = make(map[string]map[string]H2HResult)
+	}
+	return h2h, nil
+}
+
+// save writes the head-to-head file, creating its directory if needed
+func (h *HeadToHead) save(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create head-to-head directory: %v", err)
+	}
+	data, err := json.MarshalIndent(h, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode head-to-head record: %v", err)
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// puzzleKey identifies a seeded puzzle everyone gets the same mystery player for, e.g.
+// "daily 2024-03-15" or "weekly 2024-W11 day 3", or returns false for random games
+func puzzleKey(game *Game) (string, bool) {
+	switch {
+	case game.DailyDate != "":
+		return "daily " + game.DailyDate, true
+	case game.WeeklyKey != "":
+		return fmt.Sprintf("weekly %s day %d", game.WeeklyKey, game.WeeklyDay), true
+	}
+	return "", false
+}
+
+// recordHeadToHead stores a finished seeded game under the player's name
+func recordHeadToHead(game *Game, outcome GameOutcome) error {
+	key, seeded := puzzleKey(game)
+	if !seeded {
+		return nil // Random games can't be compared fairly
+	}
+	h2h, err := loadHeadToHead(game.Config.H2HFile)
+	if err != nil {
+		return err
+	}
+	if h2h.Puzzles[key] == nil {
+		h2h.Puzzles[key] = make(map[string]H2HResult)
+	}
+	h2h.Puzzles[key][game.Config.H2HName] = H2HResult{
+		Won:      outcome == OutcomeWon,
+		Attempts: game.Attempts,
+		Elapsed:  game.elapsed(),
+	}
+	return h2h.save(game.Config.H2HFile)
+}
+
+// compareH2H returns 1 if a beat b on a puzzle, -1 if b beat a, and 0 for a tie:
+// solving beats not solving, then fewer attempts win, then the faster time
+func compareH2H(a, b H2HResult) int {
+	switch {
+	case a.Won != b.Won:
+		if a.Won {
+			return 1
+		}
+		return -1
+	case !a.Won:
+		return 0 // Neither solved it
+	case a.Attempts != b.Attempts:
+		if a.Attempts < b.Attempts {
+			return 1
+		}
+		return -1
+	case a.Elapsed != b.Elapsed:
+		if a.Elapsed < b.Elapsed {
+			return 1
+		}
+		return -1
+	}
+	return 0
+}
+
+// tally returns a's record against b over every puzzle both of them finished
+func (h *HeadToHead) tally(a, b string) H2HTally {
+	var tally H2HTally
+	for _, results := range h.Puzzles {
+		resultA, playedA := results[a]
+		resultB, playedB := results[b]
+		if !playedA || !playedB {
+			continue
+		}
+		switch compareH2H(resultA, resultB) {
+		case 1:
+			tally.Wins++
+		case -1:
+			tally.Losses++
+		default:
+			tally.Ties++
+		}
+	}
+	return tally
+}
+
+// players returns every name in the record in alphabetical order
+func (h *HeadToHead) players() []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, results := range h.Puzzles {
+		for name := range results {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// printHeadToHead reports every pairing's record over the puzzles both players finished
+func printHeadToHead(h2h *HeadToHead) {
+	names := h2h.players()
+	fmt.Println("🤝 Head-to-head on shared daily and weekly puzzles:")
+	printed := false
+	for i, a := range names {
+		for _, b := range names[i+1:] {
+			tally := h2h.tally(a, b)
+			total := tally.Wins + tally.Losses + tally.Ties
+			if total == 0 {
+				continue
+			}
+			verdict := "all square"
+			if tally.Wins > tally.Losses {
+				verdict = a + " leads"
+			} else if tally.Losses > tally.Wins {
+				verdict = b + " leads"
+			}
+			fmt.Printf("  %s vs %s: %d-%d with %d tie(s) over %d puzzle(s) - %s\n", a, b, tally.Wins, tally.Losses, tally.Ties, total, verdict)
+			printed = true
+		}
+	}
+	if !printed {
+		fmt.Println("  No shared puzzles yet. Play the same -daily or -weekly puzzles with -h2h-name to compare.")
+	}
+}
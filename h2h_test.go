@@ -0,0 +1,86 @@
+package main
+
+import (
+	"path/filepath" // Package for building file paths
+	"testing"       // Package for Go tests
+	"time"          // Package for time-related operations
+)
+
+// TestCompareH2H checks the order results are ranked in: solving, then fewer attempts, then time
+func TestCompareH2H(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b H2HResult
+		want int
+	}{
+		{"solved beats unsolved", H2HResult{Won: true, Attempts: 8}, H2HResult{Attempts: 2}, 1},
+		{"fewer attempts", H2HResult{Won: true, Attempts: 5, Elapsed: time.Minute}, H2HResult{Won: true, Attempts: 3, Elapsed: 2 * time.Minute}, -1},
+		{"faster", H2HResult{Won: true, Attempts: 3, Elapsed: time.Minute}, H2HResult{Won: true, Attempts: 3, Elapsed: 2 * time.Minute}, 1},
+		{"same", H2HResult{Won: true, Attempts: 3, Elapsed: time.Minute}, H2HResult{Won: true, Attempts: 3, Elapsed: time.Minute}, 0},
+		{"both unsolved", H2HResult{Attempts: 2}, H2HResult{Attempts: 8, Elapsed: time.Hour}, 0},
+	}
+	for _, test := range tests {
+		if got := compareH2H(test.a, test.b); got != test.want {
+			t.Errorf("%s: compareH2H = %d, want %d", test.name, got, test.want)
+		}
+		if got := compareH2H(test.b, test.a); got != -test.want {
+			t.Errorf("%s: compareH2H reversed = %d, want %d", test.name, got, -test.want)
+		}
+	}
+}
+
+// TestHeadToHeadTally checks the records built from several seeded games: only puzzles both
+// players finished count, and random games aren't recorded at all
+func TestHeadToHeadTally(t *testing.T) {
+	useFallbackPlayers(t)
+	path := filepath.Join(t.TempDir(), "h2h.json")
+	record := func(name, daily, weeklyKey string, won bool, attempts int, elapsed time.Duration) {
+		t.Helper()
+		game, clock := newTestGame(t, func(config *GameConfig) {
+			config.H2HName, config.H2HFile = name, path
+		})
+		game.DailyDate = daily
+		game.WeeklyKey, game.WeeklyDay = weeklyKey, 1
+		game.Attempts = attempts
+		clock.Advance(elapsed)
+		outcome := OutcomeLost
+		if won {
+			outcome = OutcomeWon
+		}
+		if err := recordHeadToHead(game, outcome); err != nil {
+			t.Fatal(err)
+		}
+	}
+	record("ann", "2024-03-01", "", true, 3, time.Minute) // ann wins on attempts
+	record("bob", "2024-03-01", "", true, 4, 30*time.Second)
+	record("ann", "2024-03-02", "", false, 8, time.Minute) // bob wins by solving
+	record("bob", "2024-03-02", "", true, 8, time.Minute)
+	record("ann", "", "2024-W10", false, 6, time.Minute) // Neither solves: a tie
+	record("bob", "", "2024-W10", false, 6, time.Minute)
+	record("ann", "2024-03-03", "", true, 2, time.Minute) // bob never plays it
+	record("cat", "2024-03-01", "", true, 4, 20*time.Second)
+	record("bob", "", "", true, 1, time.Second) // Random game, not comparable
+
+	h2h, err := loadHeadToHead(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(h2h.Puzzles); got != 4 {
+		t.Errorf("recorded %d puzzles, want 4 without the random game", got)
+	}
+	tests := []struct {
+		a, b string
+		want H2HTally
+	}{
+		{"ann", "bob", H2HTally{Wins: 1, Losses: 1, Ties: 1}},
+		{"bob", "ann", H2HTally{Wins: 1, Losses: 1, Ties: 1}},
+		{"bob", "cat", H2HTally{Losses: 1}},
+		{"ann", "cat", H2HTally{Wins: 1}},
+		{"ann", "dan", H2HTally{}},
+	}
+	for _, test := range tests {
+		if got := h2h.tally(test.a, test.b); got != test.want {
+			t.Errorf("tally(%s, %s) = %+v, want %+v", test.a, test.b, got, test.want)
+		}
+	}
+}
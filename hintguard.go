@@ -0,0 +1,60 @@
+package main
+
+import (
+	"fmt" // Package for formatted I/O operations
+)
+
+// hintValue returns the value a hint about an attribute would reveal, so players can be grouped
+// by what the hint tells apart; ladder-only attributes are reduced the same way their hints are
+func hintValue(player Player, attribute string, config GameConfig) string {
+	switch attribute {
+	case "drafttier":
+		return draftTier(player.DraftNumber)
+	case "continent":
+		return countryContinents[player.Country]
+	case "draftdecade":
+		if player.DraftYear == unknownDraftYear {
+			return ""
+		}
+		return fmt.Sprintf("%ds", player.DraftYear/10*10)
+	case "division":
+		if team, found := findTeam(comparedTeam(player, config)); found {
+			return team.Division
+		}
+		return comparedTeam(player, config)
+	case "jerseyrange":
+		bucket, _ := jerseyBucket(player.JerseyNumber)
+		return bucket
+	}
+	return attributeValue(player, attribute, config)
+}
+
+// hintSharedBy counts the players in the database who share the target's value for an attribute,
+// counting the target itself
+func hintSharedBy(target Player, attribute string, config GameConfig) int {
+	value := hintValue(target, attribute, config)
+	count := 0
+	for _, player := range store.Players() {
+		if hintValue(player, attribute, config) == value {
+			count++
+		}
+	}
+	return count
+}
+
+// isDecisiveHint reports whether revealing an attribute would narrow the database to fewer than
+// -hint-min-shared players, all but naming the mystery player; the guard is off at 0
+func isDecisiveHint(target Player, attribute string, config GameConfig) bool {
+	return config.HintMinShared > 0 && hintSharedBy(target, attribute, config) < config.HintMinShared
+}
+
+// nonDecisiveAttributes returns the attributes whose hints keep at least -hint-min-shared players in play
+func nonDecisiveAttributes(target Player, attributes []string, config GameConfig) []string {
+	var allowed []string
+	for _, attribute := range attributes {
+		if !isDecisiveHint(target, attribute, config) {
+			allowed = append(allowed, attribute)
+		}
+	}
+	return allowed
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"math/rand" // Package for a seeded random source
+	"slices"    // Package for searching attribute lists
+	"testing"   // Package for Go tests
+)
+
+// TestHintGuardSkipsDecisive checks that -hint-min-shared keeps random hints off attributes too
+// few players share until nothing else is left, and refuses to reveal them on request
+func TestHintGuardSkipsDecisive(t *testing.T) {
+	twin := func(name, college, country string) Player {
+		p, _ := comparePlayers(func(p *Player) { p.Name, p.College, p.Country = name, college, country }, nil)
+		return p
+	}
+	target := twin("Test Target", "Test University", "Iceland") // The only player from either
+	usePlayers(t, []Player{target, twin("Test One", "Duke", "USA"), twin("Test Two", "Duke", "USA"), twin("Test Three", "UCLA", "USA")})
+
+	config := defaultConfig()
+	config.HintMinShared = 2
+	config.Rand = rand.New(rand.NewSource(1))
+	decisive := []string{"college", "country"}
+	for _, attribute := range hintAttributes {
+		if got, want := isDecisiveHint(target, attribute, config), slices.Contains(decisive, attribute); got != want {
+			t.Errorf("isDecisiveHint(%s) = %v, want %v (shared by %d)", attribute, got, want, hintSharedBy(target, attribute, config))
+		}
+	}
+	allowed := nonDecisiveAttributes(target, hintAttributes, config)
+	if len(allowed) != len(hintAttributes)-len(decisive) {
+		t.Errorf("allowed hints = %v, want every hint but %v", allowed, decisive)
+	}
+
+	used := make(map[string]bool)
+	for i := 1; i <= len(allowed); i++ {
+		if !showUniqueRandomAttributeHint(target, i, used, config) {
+			t.Fatalf("hint %d wasn't given with %d safe attributes", i, len(allowed))
+		}
+	}
+	for _, attribute := range decisive {
+		if used[attribute] {
+			t.Errorf("a random hint revealed %s while safe attributes were left", attribute)
+		}
+	}
+	if !showUniqueRandomAttributeHint(target, len(allowed)+1, used, config) {
+		t.Error("once only decisive hints are left, no hint was given")
+	}
+
+	if err := showRequestedAttributeHint(target, 1, "college", make(map[string]bool), config); err == nil {
+		t.Error("a requested decisive hint was revealed")
+	}
+	config.HintMinShared = 0
+	if err := showRequestedAttributeHint(target, 1, "college", make(map[string]bool), config); err != nil {
+		t.Errorf("with the guard off, the college hint was refused: %v", err)
+	}
+}
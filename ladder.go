@@ -0,0 +1,114 @@
+package main
+
+import (
+	"fmt"     // Package for formatted I/O operations
+	"strconv" // Package for converting strings to numbers
+	"strings" // Package for string manipulation functions
+)
+
+// defaultHintLadder reveals attributes from weakest to strongest for -hint-order=ladder
+var defaultHintLadder = []string{"continent", "draftdecade", "division", "position", "team"}
+
+// countryContinents maps countries that have produced NBA players to their continent
+var countryContinents = map[string]string{
+	"USA": "North America", "Canada": "North America", "Mexico": "North America", "Bahamas": "North America",
+	"Dominican Republic": "North America", "Jamaica": "North America", "Puerto Rico": "North America",
+	"Argentina": "South America", "Brazil": "South America", "Venezuela": "South America", "Uruguay": "South America",
+	"Serbia": "Europe", "Slovenia": "Europe", "Greece": "Europe", "France": "Europe", "Spain": "Europe",
+	"Germany": "Europe", "Italy": "Europe", "Croatia": "Europe", "Lithuania": "Europe", "Latvia": "Europe",
+	"Turkey": "Europe", "Montenegro": "Europe", "Bosnia and Herzegovina": "Europe", "Ukraine": "Europe",
+	"Russia": "Europe", "Czech Republic": "Europe", "Finland": "Europe", "Sweden": "Europe",
+	"Switzerland": "Europe", "Georgia": "Europe", "Poland": "Europe", "United Kingdom": "Europe",
+	"England": "Europe", "Netherlands": "Europe", "Belgium": "Europe", "Austria": "Europe",
+	"Cameroon": "Africa", "Nigeria": "Africa", "Senegal": "Africa", "Congo": "Africa", "DRC": "Africa",
+	"South Sudan": "Africa", "Sudan": "Africa", "Mali": "Africa", "Egypt": "Africa", "Angola": "Africa",
+	"China": "Asia", "Japan": "Asia", "Israel": "Asia", "Philippines": "Asia", "South Korea": "Asia",
+	"Australia": "Oceania", "New Zealand": "Oceania",
+}
+
+// ladderOnlyAttributes are hint attributes that only a ladder reveals, never a random hint
+var ladderOnlyAttributes = []string{"continent", "draftdecade", "division", "jerseyrange"}
+
+// isHintAttribute reports whether an attribute can be revealed by a hint
+func isHintAttribute(attribute string) bool {
+	for _, known := range append(append([]string{}, hintAttributes...), ladderOnlyAttributes...) {
+		if attribute == known {
+			return true
+		}
+	}
+	return false
+}
+
+// nextLadderAttribute returns the first ladder attribute not revealed yet, marking it as used
+func nextLadderAttribute(ladder []string, usedAttributes map[string]bool) (string, bool) {
+	for _, attribute := range ladder {
+		if !usedAttributes[attribute] {
+			usedAttributes[attribute] = true
+			return attribute, true
+		}
+	}
+	return "", false
+}
+
+// describeLadderAttribute returns a sentence for the attributes only the hint ladder reveals
+func describeLadderAttribute(target Player, attribute string, config GameConfig) string {
+	switch attribute {
+	case "continent":
+		if continent, found := countryContinents[target.Country]; found {
+			return fmt.Sprintf("The player is from %s", continent)
+		}
+		return "The player's home continent is not available"
+	case "draftdecade":
+		if target.DraftYear == unknownDraftYear {
+			return "The player's draft year is not available"
+		}
+		return fmt.Sprintf("The player was drafted in the %ds", target.DraftYear/10*10)
+	case "division":
+		if team, found := findTeam(comparedTeam(target, config)); found {
+			return fmt.Sprintf("The player's team is in the %s Division", team.Division)
+		}
+		return fmt.Sprintf("The player isn't on an NBA team (%s)", strings.ToLower(comparedTeam(target, config)))
+	case "jerseyrange":
+		if bucket, found := jerseyBucket(target.JerseyNumber); found {
+			return fmt.Sprintf("The player's jersey number is %s", bucket)
+		}
+		return "The player's jersey number is not available"
+	}
+	return ""
+}
+
+// jerseyBucket describes roughly where a jersey number falls without giving it away, e.g.
+// "a single digit" for #0, #00, or #7, or "in the 20s" for #23
+// Returns false for Unknown or any jersey that isn't a plain number
+func jerseyBucket(jersey string) (string, bool) {
+	number, err := strconv.Atoi(strings.TrimPrefix(strings.TrimSpace(jersey), "#"))
+	if err != nil || number < 0 {
+		return "", false
+	}
+	switch {
+	case number < 10:
+		return "a single digit", true
+	case number < 20:
+		return "in the teens", true
+	}
+	return fmt.Sprintf("in the %ds", number/10*10), true
+}
+
+// parseHintLadder reads a comma-separated ladder such as "continent,division,team"
+func parseHintLadder(value string) ([]string, error) {
+	var ladder []string
+	for _, attribute := range strings.Split(value, ",") {
+		attribute = strings.ToLower(strings.TrimSpace(attribute))
+		if attribute == "" {
+			continue
+		}
+		if !isHintAttribute(attribute) {
+			return nil, fmt.Errorf("unknown hint attribute %q", attribute)
+		}
+		ladder = append(ladder, attribute)
+	}
+	if len(ladder) == 0 {
+		return nil, fmt.Errorf("hint ladder is empty")
+	}
+	return ladder, nil
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"reflect" // Package for comparing reveal orders
+	"slices"  // Package for searching slices
+	"testing" // Package for Go tests
+)
+
+// ladderReveals gives hints one at a time and returns the attribute each one revealed
+func ladderReveals(config GameConfig, hints int) []string {
+	used := make(map[string]bool)
+	var revealed []string
+	for i := 1; i <= hints; i++ {
+		before := make(map[string]bool)
+		for attribute := range used {
+			before[attribute] = true
+		}
+		if !showUniqueRandomAttributeHint(getFallbackPlayers()[0], i, used, config) {
+			break
+		}
+		for attribute := range used {
+			if !before[attribute] {
+				revealed = append(revealed, attribute)
+			}
+		}
+	}
+	return revealed
+}
+
+// TestHintLadderOrder checks that ladder hints reveal the configured attributes in order
+// before falling back to random hints
+func TestHintLadderOrder(t *testing.T) {
+	useFallbackPlayers(t)
+	tests := []struct {
+		ladder string
+		want   []string
+	}{
+		{"continent,draftdecade,division,position,team", []string{"continent", "draftdecade", "division", "position", "team"}},
+		{"Division, team", []string{"division", "team"}},
+		{"jerseyrange,country,college", []string{"jerseyrange", "country", "college"}},
+	}
+	for _, test := range tests {
+		config, err := parseTestFlags(t, "-hint-order", "ladder", "-hint-ladder", test.ladder)
+		if err != nil {
+			t.Fatal(err)
+		}
+		config.HintMinShared = 0 // Every attribute may be revealed
+		got := ladderReveals(config, len(test.want)+1)
+		if !reflect.DeepEqual(got[:len(test.want)], test.want) {
+			t.Errorf("-hint-ladder=%s revealed %v, want %v first", test.ladder, got, test.want)
+		}
+		if len(got) != len(test.want)+1 || slices.Contains(ladderOnlyAttributes, got[len(test.want)]) {
+			t.Errorf("-hint-ladder=%s: hint after the ladder revealed %v, want a random attribute", test.ladder, got[len(test.want):])
+		}
+	}
+}
+
+// TestParseHintLadderRejects checks that unknown attributes and empty ladders are refused
+func TestParseHintLadderRejects(t *testing.T) {
+	for _, value := range []string{"continent,shoesize", "", " , "} {
+		if _, err := parseHintLadder(value); err == nil {
+			t.Errorf("parseHintLadder(%q) accepted a bad ladder", value)
+		}
+	}
+}
+
+// TestJerseyBucket checks how jersey numbers in each range are described, and that unknown or
+// non-numeric jerseys give no hint
+func TestJerseyBucket(t *testing.T) {
+	tests := []struct {
+		jersey string
+		want   string
+		found  bool
+	}{
+		{"0", "a single digit", true},
+		{"00", "a single digit", true},
+		{"7", "a single digit", true},
+		{"9", "a single digit", true},
+		{"10", "in the teens", true},
+		{"19", "in the teens", true},
+		{"20", "in the 20s", true},
+		{"23", "in the 20s", true},
+		{"35", "in the 30s", true},
+		{"99", "in the 90s", true},
+		{"#30", "in the 30s", true},
+		{" 6 ", "a single digit", true},
+		{"Unknown", "", false},
+		{"", "", false},
+		{"-1", "", false},
+		{"3A", "", false},
+	}
+	for _, test := range tests {
+		got, found := jerseyBucket(test.jersey)
+		if got != test.want || found != test.found {
+			t.Errorf("jerseyBucket(%q) = %q, %v; want %q, %v", test.jersey, got, found, test.want, test.found)
+		}
+	}
+
+	target := getFallbackPlayers()[0]
+	target.JerseyNumber = "Unknown"
+	if got := describeLadderAttribute(target, "jerseyrange", defaultConfig()); got != "The player's jersey number is not available" {
+		t.Errorf("the jersey range hint for an unknown jersey = %q", got)
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt" // Package for formatted I/O operations
+)
+
+// legendEntry explains one column of the comparison table
+type legendEntry struct {
+	Column      string // Column header as shown in the table
+	Description string // What the column compares and when it turns yellow
+}
+
+// closeRule describes a tolerance, e.g. "yellow within ±2 years", or that only exact values count
+func closeRule(tolerance int, singular, plural string) string {
+	if tolerance <= 0 {
+		return "exact only, no yellow range"
+	}
+	return fmt.Sprintf("yellow within ±%d %s", tolerance, unit(tolerance, singular, plural))
+}
+
+// legendEntries returns the explanation of every table column for the configured tolerances,
+// so the legend always matches how guesses are actually compared
+func legendEntries(config GameConfig) []legendEntry {
+	team := "Current team; green only for the same team (two free agents can't be compared)"
+	if config.TeamMode == "iconic" {
+		team = "Team the player is best known for (current team if none); green only for the same team"
+	}
+	position := "Playing position; green only for the same position, yellow for a generic G or F against PG/SG or SF/PF"
+	if config.StrictPositions {
+		position = "Playing position; green only for the same position"
+	}
+	pick := "Overall draft pick; " + closeRule(config.DraftPickTolerance, "pick", "picks") +
+		", or in the same tier: lottery (#1-14), late first round (#15-30), second round (#31-60)"
+	switch config.PickGap {
+	case "number":
+		pick += ". Close picks show the gap, e.g. +3 when the mystery player went 3 picks later"
+	case "arrow":
+		pick += ". Close picks show ↑ when the mystery player went later, ↓ when earlier"
+	case "bucket":
+		pick += ". Close picks show how near they are, e.g. ≤2 for within 2 picks"
+	}
+	return []legendEntry{
+		{"NAME", "The player you guessed; green means you found the mystery player"},
+		{"TEAM", team},
+		{"POSITION", position},
+		{"HEIGHT", "Height in feet and inches; " + closeRule(config.HeightToleranceInches, "inch", "inches")},
+		{"WEIGHT", "Weight in pounds; " + closeRule(config.WeightToleranceLbs, "lb", "lbs")},
+		{"COLLEGE", "College attended (None for players who skipped college); green only for the same college"},
+		{"DRAFT YR", "Year the player was drafted; " + closeRule(config.DraftYearTolerance, "year", "years")},
+		{"ROUND", "Draft round (Undrafted for undrafted players); green only for the same round"},
+		{"PICK", pick},
+		{"JERSEY", "Jersey number; green only for the same number"},
+		{"COUNTRY", "Country the player is from; green only for the same country"},
+	}
+}
+
+// printLegend explains the markers and every comparison column
+func printLegend(config GameConfig) {
+	theme := config.theme()
+	fmt.Println("📖 Legend")
+	fmt.Printf("  %s exact match   %s close   %s no match   %s can't compare (missing data)\n",
+		theme.marker(MatchExact), theme.marker(MatchClose), theme.marker(MatchMiss), theme.marker(MatchUnknown))
+	for _, entry := range legendEntries(config) {
+		fmt.Printf("  %-9s %s\n", entry.Column, entry.Description)
+	}
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"strings" // Package for string manipulation functions
+	"testing" // Package for Go tests
+)
+
+// TestLegendReflectsTolerances checks that the legend states the tolerances the game will
+// actually compare with, for each difficulty and for custom values
+func TestLegendReflectsTolerances(t *testing.T) {
+	describe := func(config GameConfig) map[string]string {
+		descriptions := make(map[string]string)
+		for _, entry := range legendEntries(config) {
+			descriptions[entry.Column] = entry.Description
+		}
+		return descriptions
+	}
+
+	for _, difficulty := range []string{"easy", "normal", "hard"} {
+		config, err := parseTestFlags(t, "-difficulty", difficulty)
+		if err != nil {
+			t.Fatal(err)
+		}
+		legend := describe(config)
+		preset := difficultyPresets[difficulty]
+		wants := map[string]string{
+			"HEIGHT":   closeRule(preset.HeightToleranceInches, "inch", "inches"),
+			"WEIGHT":   closeRule(preset.WeightToleranceLbs, "lb", "lbs"),
+			"DRAFT YR": closeRule(preset.DraftYearTolerance, "year", "years"),
+			"PICK":     closeRule(preset.DraftPickTolerance, "pick", "picks"),
+		}
+		for column, want := range wants {
+			if !strings.Contains(legend[column], want) {
+				t.Errorf("%s legend for %s = %q, want it to say %q", difficulty, column, legend[column], want)
+			}
+		}
+	}
+
+	config := defaultConfig()
+	config.DraftYearTolerance, config.HeightToleranceInches, config.WeightToleranceLbs = 4, 0, 1
+	config.StrictPositions = true
+	legend := describe(config)
+	wants := map[string]string{
+		"DRAFT YR": "yellow within ±4 years",
+		"HEIGHT":   "exact only, no yellow range",
+		"WEIGHT":   "yellow within ±1 lb",
+	}
+	for column, want := range wants {
+		if !strings.HasSuffix(legend[column], want) {
+			t.Errorf("legend for %s = %q, want it to end %q", column, legend[column], want)
+		}
+	}
+	if strings.Contains(legend["POSITION"], "yellow") {
+		t.Errorf("with -strict-positions the position legend still mentions yellow: %q", legend["POSITION"])
+	}
+}
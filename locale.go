@@ -0,0 +1,72 @@
+package main
+
+import (
+	"fmt"     // Package for formatted I/O operations
+	"sort"    // Package for sorting slices
+	"strconv" // Package for converting numbers to strings
+	"strings" // Package for string manipulation functions
+)
+
+// Locale holds the words and separators used when formatting durations and numbers
+type Locale struct {
+	Minute            string // Singular minute unit
+	Minutes           string // Plural minute unit
+	Second            string // Singular second unit
+	Seconds           string // Plural second unit
+	ThousandSeparator string // Placed between groups of three digits
+}
+
+// locales is the message catalog selectable with -locale
+var locales = map[string]Locale{
+	"en": {Minute: "minute", Minutes: "minutes", Second: "second", Seconds: "seconds", ThousandSeparator: ","},
+	"es": {Minute: "minuto", Minutes: "minutos", Second: "segundo", Seconds: "segundos", ThousandSeparator: "."},
+	"fr": {Minute: "minute", Minutes: "minutes", Second: "seconde", Seconds: "secondes", ThousandSeparator: " "},
+	"de": {Minute: "Minute", Minutes: "Minuten", Second: "Sekunde", Seconds: "Sekunden", ThousandSeparator: "."},
+}
+
+// currentLocale is the locale used for all output, English unless -locale says otherwise
+var currentLocale = locales["en"]
+
+// localeNames returns the available locale codes in sorted order
+func localeNames() []string {
+	names := make([]string, 0, len(locales))
+	for name := range locales {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// setLocale switches the output locale by case-insensitive code
+func setLocale(name string) error {
+	locale, found := locales[strings.ToLower(name)]
+	if !found {
+		return fmt.Errorf("unknown locale %q (choose %s)", name, strings.Join(localeNames(), ", "))
+	}
+	currentLocale = locale
+	return nil
+}
+
+// unit picks the singular or plural form of a unit for a count
+func unit(count int, singular, plural string) string {
+	if count == 1 {
+		return singular
+	}
+	return plural
+}
+
+// formatCount formats a number with the locale's thousand separator, e.g. "4,512"
+func formatCount(n int) string {
+	digits := strconv.Itoa(abs(n))
+	var grouped strings.Builder
+	for i, digit := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			grouped.WriteString(currentLocale.ThousandSeparator)
+		}
+		grouped.WriteRune(digit)
+	}
+	if n < 0 {
+		return "-" + grouped.String()
+	}
+	return grouped.String()
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"testing" // Package for Go tests
+	"time"    // Package for time-related operations
+)
+
+// useLocale switches the output locale for one test
+func useLocale(t *testing.T, name string) {
+	t.Helper()
+	previous := currentLocale
+	if err := setLocale(name); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { currentLocale = previous })
+}
+
+// TestLocaleDurations checks verbose durations in alternate locales, singular and plural
+func TestLocaleDurations(t *testing.T) {
+	tests := []struct {
+		locale   string
+		duration time.Duration
+		want     string
+	}{
+		{"es", time.Second, "1 segundo"},
+		{"es", 3*time.Minute + 12*time.Second, "3 minutos 12 segundos"},
+		{"de", time.Minute + time.Second, "1 Minute 1 Sekunde"},
+		{"DE", 42 * time.Second, "42 Sekunden"},
+		{"fr", 2*time.Minute + 2*time.Second, "2 minutes 2 secondes"},
+	}
+	for _, test := range tests {
+		useLocale(t, test.locale)
+		if got := formatDurationStyle(test.duration, durationVerbose); got != test.want {
+			t.Errorf("%s: %v = %q, want %q", test.locale, test.duration, got, test.want)
+		}
+	}
+
+	// The compact and clock styles use unit letters in every locale
+	useLocale(t, "de")
+	if got := formatDurationStyle(3*time.Minute+12*time.Second, durationClock); got != "3m 12s" {
+		t.Errorf("de clock style = %q, want 3m 12s", got)
+	}
+}
+
+// TestLocaleCounts checks each locale's thousand separator
+func TestLocaleCounts(t *testing.T) {
+	tests := map[string]string{"en": "4,512,300", "es": "4.512.300", "fr": "4 512 300", "de": "4.512.300"}
+	for locale, want := range tests {
+		useLocale(t, locale)
+		if got := formatCount(4512300); got != want {
+			t.Errorf("%s: formatCount = %q, want %q", locale, got, want)
+		}
+	}
+	useLocale(t, "en")
+	if got := formatCount(-1000); got != "-1,000" {
+		t.Errorf("formatCount(-1000) = %q", got)
+	}
+}
+
+// TestSetLocaleUnknown checks that an unknown locale is refused and the current one kept
+func TestSetLocaleUnknown(t *testing.T) {
+	useLocale(t, "es")
+	if err := setLocale("xx"); err == nil {
+		t.Error("setLocale accepted an unknown locale")
+	}
+	if currentLocale != locales["es"] {
+		t.Error("a refused locale replaced the current one")
+	}
+}
@@ -7,198 +7,936 @@ import (
 	"os"        // Package for operating system interface, used for standard input
 	"strings"   // Package for string manipulation functions
 	"time"      // Package for time-related operations
+	"unicode"   // Package for classifying letters in name hints
 )
 
 // main is the entry point of the program
 func main() {
+	// Read command-line options before doing any work
+	config, err := parseFlags(os.Args[1:])
+	if err != nil {
+		os.Exit(2) // The flag package has already printed the problem and usage
+	}
+
+	setLocale(config.Locale) // Already validated by parseFlags
+
+	// The analytics report only reads the stats file, so it doesn't need any players
+	if config.Analytics {
+		stats, err := loadStats(config.StatsFile)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+		printAnalytics(stats)
+		return
+	}
+	if config.H2HReport {
+		h2h, err := loadHeadToHead(config.H2HFile)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+		printHeadToHead(h2h)
+		return
+	}
+
+	input := startInputReader(bufio.NewScanner(os.Stdin)) // Read user input from terminal on a single goroutine
+	watchResize()                                         // Keep the terminal width current if the window is resized
+
+	// Offer the settings menu to interactive players who didn't pass any flags
+	if len(os.Args) == 1 && !config.NoMenu && isTerminal(os.Stdin) {
+		runSettingsMenu(&config, input)
+	}
+
+	// Unknown themes fall back to the default markers
+	if _, found := getTheme(config.Theme); !found {
+		fmt.Printf("Warning: unknown theme %q, using default (available: %s)\n", config.Theme, strings.Join(themeNames(), ", "))
+		config.Theme = "default"
+	}
+	// The fallback list is the last line of defense, so it must always be usable
+	if err := validateFallbackPlayers(getFallbackPlayers()); err != nil {
+		fmt.Printf("❌ Internal error: %v\n", err)
+		os.Exit(1)
+	}
+
 	// Initialize players from API
 	fmt.Println("🏀 HOOP DETECTIVE 🏀")
 	fmt.Println("Loading NBA player database...")
 
+	// A missing API key is handled once, up front, instead of on every request
+	if err := offerAPIKey(config, input, isTerminal(os.Stdin) && isTerminal(os.Stdout)); err != nil {
+		fmt.Printf("❌ %v\n", err)
+		os.Exit(1)
+	}
+
 	// Attempt to load player data from NBA API or fallback to hardcoded data
-	err := initializePlayers()
+	err = initializePlayers(config)
 	if err != nil {
 		// Display warning if API loading failed but continue with fallback data
 		fmt.Printf("Warning: Could not load full player database: %v\n", err)
 		fmt.Println("Using fallback player data...")
 	}
 
-	// Initialize game variables
-	target := getRandomPlayer()                 // Select a random player as the mystery player to guess
-	attempts := 0                               // Counter for number of guesses made
-	maxAttempts := 8                            // Maximum number of guesses allowed
-	hintsUsed := 0                              // Counter for number of hints used
-	maxHints := 3                               // Maximum number of hints allowed
-	usedHintAttributes := make(map[string]bool) // Track which attributes have been revealed
-	scanner := bufio.NewScanner(os.Stdin)       // Create scanner to read user input from terminal
+	// Watching a replay needs the players but no game
+	if config.PlayReplay != "" {
+		if err := playReplay(config.PlayReplay, config); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	// A draft class with only a handful of players makes for a trivial game
+	if err := checkDraftClass(config); err != nil {
+		fmt.Printf("❌ %v\n", err)
+		os.Exit(1)
+	}
+
+	// Candidates that aren't loaded can't be the answer, so say so up front
+	for _, name := range config.Candidates {
+		if _, found := store.PlayerByName(name); !found {
+			fmt.Printf("Warning: candidate %q is not in the player pool\n", name)
+		}
+	}
+
+	// A tiny pool could be brute-forced within the attempt limit
+	if message := fitAttemptsToPool(&config); message != "" {
+		fmt.Printf("⚠️  %s.\n", message)
+	}
+
+	// Point out excluded names that don't match anyone, most likely typos
+	for name := range config.ExcludedTargets {
+		if _, found := store.PlayerByName(name); !found {
+			fmt.Printf("Warning: excluded player %q is not in the player pool\n", name)
+		}
+	}
 
-	// Timer setup
-	gameStartTime := time.Now()
-	gameDuration := 6 * time.Minute // 6 minutes total
-	gameEndTime := gameStartTime.Add(gameDuration)
+	// Random games avoid the mystery players of recent sessions
+	if config.AvoidRecent > 0 {
+		if stats, err := loadStats(config.StatsFile); err != nil {
+			fmt.Printf("Warning: could not read recent mystery players: %v\n", err)
+		} else {
+			config.RecentTargets = stats.Recent
+		}
+	}
+
+	// Either pick up a saved game or start a fresh one
+	var game *Game
+	if config.Resume {
+		game, err = loadGame(config, config.SaveFile)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+		os.Remove(config.SaveFile) // The save is consumed once resumed
+	} else if config.Daily {
+		// Everyone gets the same date-seeded player, playable once per day
+		key := dailyKey(time.Now())
+		stats, err := loadStats(config.StatsFile)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+		if result, played := stats.Daily[key]; played {
+			verdict := "lost"
+			if result.Won {
+				verdict = "won"
+			}
+			fmt.Printf("📅 You've already played the %s daily (%s in %d attempts). Come back tomorrow!\n", key, verdict, result.Attempts)
+			return
+		}
+		target, err := getDailyPlayer(key, config)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+		game = newGame(config, target)
+		game.DailyDate = key
+		fmt.Printf("📅 Daily challenge for %s\n", key)
+	} else if config.Weekly {
+		// Seven week-seeded players, one unlocked each day; missed days can be caught up until Sunday
+		now := time.Now()
+		key, today := weekKey(now), weekDay(now)
+		stats, err := loadStats(config.StatsFile)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+		result := stats.weeklyResult(key)
+		printWeeklySummary(key, result, today)
+		day, available := nextWeeklyDay(result, today)
+		if !available {
+			if today == daysPerWeek {
+				fmt.Println("🗓️  You've finished this week's challenge. A new one starts Monday!")
+			} else {
+				fmt.Println("🗓️  You're all caught up. Tomorrow's puzzle unlocks at midnight UTC!")
+			}
+			return
+		}
+		target, err := getWeeklyPlayer(key, day, config)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+		game = newGame(config, target)
+		game.WeeklyKey, game.WeeklyDay = key, day
+		fmt.Printf("🗓️  Weekly challenge %s, puzzle %d of %d\n", key, day, daysPerWeek)
+	} else if config.TargetName != "" {
+		// A forced mystery player reproduces a reported game exactly
+		target, err := getForcedPlayer(config)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+		game = newGame(config, target)
+	} else {
+		// Select a random player as the mystery player to guess
+		target, err := getRandomPlayer(config)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+		rememberRandomTarget(&config, target)
+		game = newGame(config, target)
+	}
 
 	// Print game instructions and setup information
-	printInstructions()
-	fmt.Printf("\nYou have %d attempts and 6 minutes to guess the mystery NBA player!\n", maxAttempts)
-	fmt.Printf("You can use up to %d hints by typing 'hint'.\n", maxHints)
-	fmt.Println("Type 'quit' to exit the game.")
-	fmt.Printf("⏰ Game started at: %s\n", gameStartTime.Format("15:04:05"))
-	fmt.Printf("⏰ Time limit: %s\n", gameEndTime.Format("15:04:05"))
-	fmt.Println("💡 Tip: Player names are case-insensitive (e.g., 'lebron james' works)")
+	printInstructions(config)
+	if config.Legend {
+		printLegend(config)
+	}
+
+	// Endurance games all share one deadline, set once the first game starts
+	run := newSession(config, game)
+	if config.Endurance > 0 {
+		fmt.Printf("🏃 Endurance: solve as many mystery players as you can in %s!\n", formatDuration(config.Endurance, config))
+	}
+
+	// Keep playing games until the player quits or declines another round
+	gamesPlayed, gamesWon := 0, 0
+	var sessionGames []*Game
+	for {
+		printGameIntro(game)
+		outcome := playMode(game, input)
+
+		// The quiz is about the revealed player, so it waits until the answer has been shown
+		if config.Quiz && game.AnswerShown && config.Mode != "team" {
+			game.QuizPoints = playQuiz(game, input)
+		}
+
+		if config.TimeSplits && outcome != OutcomeQuit {
+			printTimeSplits(game)
+		}
+		if summaryEnabled(config, isTerminal(os.Stdin)) {
+			printGameSummary(game, outcome)
+		}
+
+		// Rewrite the export after every game so it's complete even if the session is interrupted
+		sessionGames = append(sessionGames, game)
+		if config.CSVFile != "" {
+			if err := writeSessionCSV(config.CSVFile, sessionGames); err != nil {
+				fmt.Printf("Warning: could not export guesses: %v\n", err)
+			}
+		}
+
+		// Only finished player-mode games have a guess history worth replaying
+		if config.ReplayFile != "" && outcome != OutcomeQuit && config.Mode == "player" {
+			if err := writeReplay(config.ReplayFile, game, outcome); err != nil {
+				fmt.Printf("Warning: could not save replay: %v\n", err)
+			}
+		}
+
+		if outcome == OutcomeQuit {
+			break // Quitting saves the game, so there's nothing more to play
+		}
+
+		// A finished daily can't be played again today, even if it was played in zen mode
+		if game.DailyDate != "" {
+			if err := recordDailyResult(game, outcome); err != nil {
+				fmt.Printf("Warning: could not record daily result: %v\n", err)
+			}
+		}
+		if game.WeeklyKey != "" {
+			if err := recordWeeklyResult(game, outcome); err != nil {
+				fmt.Printf("Warning: could not record weekly result: %v\n", err)
+			}
+		}
 
-	// Print header row for the comparison results table
-	printHeader()
+		if config.H2HName != "" {
+			if err := recordHeadToHead(game, outcome); err != nil {
+				fmt.Printf("Warning: could not record head-to-head result: %v\n", err)
+			}
+		}
+
+		// Per-player analytics are opt-in; team games are about the team, not the player
+		if config.TrackPlayers && config.Mode != "team" {
+			if err := recordPlayerStats(game, outcome); err != nil {
+				fmt.Printf("Warning: could not record player analytics: %v\n", err)
+			}
+		}
+
+		// Track results across games in this session
+		gamesPlayed++
+		if outcome == OutcomeWon {
+			gamesWon++
+		}
+
+		// Endurance moves straight on to the next player until the shared clock runs out
+		if config.Endurance > 0 {
+			if run.enduranceOver(outcome) {
+				break
+			}
+		} else {
+			if config.NoReplayPrompt || !run.playAgain(input) {
+				break
+			}
+		}
+
+		// Reuse the loaded database and make sure the new mystery player is different
+		target, err := getNextRandomPlayer(game.Target, config)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			break
+		}
+		rememberRandomTarget(&config, target)
+		game = run.nextGame(config, target)
+		if config.Endurance > 0 {
+			fmt.Printf("\n🏃 %d solved - %s left on the clock. Next mystery player!\n", gamesWon, formatTimeRemaining(game.timeRemaining()))
+		}
+	}
+
+	if config.Endurance > 0 {
+		fmt.Printf("\n🏁 Endurance over: you solved %d player(s) in %s.\n", gamesWon, formatDuration(config.Endurance, config))
+	}
+	if config.SessionTime > 0 {
+		printSessionTotals(sessionGames, gamesPlayed, gamesWon, run.elapsed(), config)
+	} else if gamesPlayed > 1 {
+		fmt.Printf("\n📊 Session: won %d of %d games.\n", gamesWon, gamesPlayed)
+	}
+}
+
+// printSessionTotals displays the cumulative results of a time-capped session; guesses and hints
+// also count a game quit (and saved) at the end
+func printSessionTotals(games []*Game, played, won int, elapsed time.Duration, config GameConfig) {
+	guesses, hints := 0, 0
+	for _, game := range games {
+		guesses += game.Attempts
+		hints += game.HintsUsed
+	}
+	fmt.Printf("\n📊 Session: won %d of %d %s in %s, with %d %s and %d %s.\n",
+		won, played, unit(played, "game", "games"), formatDuration(elapsed, config),
+		guesses, unit(guesses, "guess", "guesses"), hints, unit(hints, "hint", "hints"))
+}
+
+// playMode plays one game with the engine of its mode: attributes, team, or player guesses
+func playMode(game *Game, input <-chan string) GameOutcome {
+	switch game.Config.Mode {
+	case "attributes":
+		return playAttributeGame(game, input)
+	case "team":
+		return playTeamGame(game, input)
+	}
+	return playGame(game, input)
+}
+
+// printGameIntro displays the rules summary and table header at the start of each game
+func printGameIntro(game *Game) {
+	config := game.Config
+	kind, _ := mysteryAnswer(game)
+	fmt.Printf("\nYou have %s to guess the mystery NBA %s!\n", config.limitsDescription(), kind)
+	fmt.Printf("You can use up to %d hints by typing 'hint'.\n", config.MaxHints)
+	if config.Mode == "team" {
+		fmt.Println("Team mode: guess the mystery NBA team. Each guess shows whether its conference, division, and city match.")
+		fmt.Println("Type 'hint' for a clue (conference, division, then a player on the team), or 'quit' to save and exit the game.")
+	} else if config.Mode == "attributes" {
+		fmt.Println("Attribute mode: guess one attribute at a time (e.g. 'position: C', 'country: Serbia'), then name the player to win.")
+		fmt.Println("Type 'attributes' to list attribute names, 'hint' for a clue, or 'quit' to save and exit the game.")
+	} else {
+		fmt.Println("Type 'quit' to save and exit the game, or 'help' for all commands.")
+	}
+	fmt.Printf("⏰ Game started at: %s\n", game.StartTime.Format("15:04:05"))
+	if game.isTimed() {
+		fmt.Printf("⏰ Time limit: %s\n", game.Deadline.Format("15:04:05"))
+	}
+	if config.Mode == "team" {
+		fmt.Println("💡 Tip: Team names are case-insensitive, and nicknames and abbreviations work too (e.g., 'celtics' or 'BOS')")
+	} else {
+		fmt.Println("💡 Tip: Player names are case-insensitive (e.g., 'lebron james' works)")
+	}
+	if len(config.Candidates) > 0 {
+		var names []string
+		for _, player := range candidatePlayers(config) {
+			names = append(names, player.Name)
+		}
+		fmt.Printf("🎯 The mystery player is one of these %d: %s\n", len(names), strings.Join(names, ", "))
+	}
+	if config.Hardcore {
+		fmt.Println("💀 Hardcore: a name that isn't found costs an attempt, and there are no automatic name hints")
+	}
+	if config.Pace > 0 {
+		fmt.Printf("🐢 Pace: guess at least every %s or lose a hint (an attempt once hints run out)\n", config.Pace)
+	}
+	if config.StarterClue {
+		showStarterClue(game)
+	}
+
+	// Print header row for the comparison results table (attribute mode has no table)
+	if !config.Compact && config.Mode == "player" {
+		printHeader(config)
+	}
+
+	// Show the board so far when continuing a saved game, up to the last -history-limit guesses
+	recent, hidden := game.recentHistory(config.HistoryLimit)
+	if hidden > 0 {
+		fmt.Printf("... %d earlier %s\n", hidden, unit(hidden, "guess", "guesses"))
+	}
+	for _, record := range recent {
+		fmt.Println(game.renderRecord(record))
+	}
+}
+
+// isYes reports whether an answer to a y/n prompt means yes
+func isYes(answer string) bool {
+	return strings.HasPrefix(strings.ToLower(strings.TrimSpace(answer)), "y")
+}
+
+// startInputReader reads lines from the scanner on one goroutine so every prompt shares
+// the same source; the returned channel is closed when input ends
+func startInputReader(scanner *bufio.Scanner) <-chan string {
+	input := make(chan string)
+	go func() {
+		defer close(input)
+		for scanner.Scan() {
+			input <- sanitizeInput(scanner.Text()) // Every guess and answer is echoed, so clean it once here
+		}
+	}()
+	return input
+}
+
+// waitForInput waits for the next line of input until the timeout fires (a nil timeout never fires)
+// Returns false if time ran out or input ended
+func waitForInput(input <-chan string, timeout <-chan time.Time) (string, bool) {
+	select {
+	case line, ok := <-input:
+		return line, ok
+	case <-timeout:
+		return "", false
+	}
+}
+
+// playGame runs the main game loop until the player wins, loses, runs out of time, or quits
+// Returns how the game ended
+func playGame(game *Game, input <-chan string) GameOutcome {
+	target := game.Target
 
 	// Main game loop - continues until max attempts reached, time runs out, or player guesses correctly
-	for attempts < maxAttempts {
+	for game.attemptsLeft() > 0 {
 		// Check if time has run out
-		currentTime := time.Now()
-		if currentTime.After(gameEndTime) {
-			fmt.Printf("\n⏰ TIME'S UP! You ran out of time after %s.\n", formatDuration(currentTime.Sub(gameStartTime)))
-			fmt.Printf("The mystery player was: %s\n", target.Name)
-			printPlayerDetails(target)
-			return
+		if game.timeExpired() {
+			fmt.Printf("\n⏰ TIME'S UP! You ran out of time after %s.\n", formatDuration(game.elapsed(), game.Config))
+			return endOnTimeUp(game, input)
 		}
 
-		// Calculate and display remaining time
-		timeRemaining := gameEndTime.Sub(currentTime)
-
 		// Display current attempt number, time remaining, and prompt for user input
-		fmt.Printf("\nAttempt %d/%d - Time remaining: %s - Enter your guess: ",
-			attempts+1, maxAttempts, formatTimeRemaining(timeRemaining))
-
-		// Read user input from terminal with timeout handling
-		inputChan := make(chan string, 1)
-		go func() {
-			if scanner.Scan() {
-				inputChan <- scanner.Text()
-			} else {
-				inputChan <- ""
-			}
-		}()
+		if game.isTimed() {
+			fmt.Printf("\nAttempt %s - Time remaining: %s - Enter your guess: ",
+				game.attemptLabel(), formatTimeRemaining(game.timeRemaining()))
+		} else {
+			fmt.Printf("\nAttempt %s - Enter your guess: ", game.attemptLabel())
+		}
 
 		// Wait for input or timeout
 		select {
-		case guess := <-inputChan:
+		case guess, ok := <-input:
+			// Treat the end of input like typing 'quit' so the game is saved
+			if !ok {
+				guess = "quit"
+			}
+
 			// Process the user's input
 			guess = strings.TrimSpace(guess)
 
 			// Check if user wants to quit the game
 			if strings.ToLower(guess) == "quit" {
-				fmt.Println("\nThanks for playing! The mystery player was:", target.Name)
-				return // Exit the program
+				return quitAndSave(game)
 			}
 
-			// Check if user wants to use a hint
-			if strings.ToLower(guess) == "hint" {
-				if hintsUsed >= maxHints {
-					fmt.Printf("❌ You've already used all %d hints!\n", maxHints)
+			// Check if user wants to use a hint, either random ("hint") or targeted ("hint team")
+			if fields := strings.Fields(strings.ToLower(guess)); len(fields) > 0 && fields[0] == "hint" {
+				if game.hintsLeft() <= 0 {
+					fmt.Printf("❌ You've already used all %d hints!\n", game.Config.MaxHints)
 					continue // Don't count this as an attempt, go to next iteration
 				}
+				if len(fields) > 1 {
+					requested := strings.Join(fields[1:], " ")
+					if err := showRequestedAttributeHint(target, game.HintsUsed+1, requested, game.UsedHintAttributes, game.Config); err != nil {
+						fmt.Printf("❌ %v\n", err)
+						continue // Invalid requests don't use up a hint
+					}
+					game.HintsUsed++
+					fmt.Printf("💡 Hints remaining: %d\n", game.hintsLeft())
+					continue
+				}
 
 				// Show a unique random attribute hint
-				hintGiven := showUniqueRandomAttributeHint(target, hintsUsed+1, usedHintAttributes)
-				if hintGiven {
-					hintsUsed++
-					fmt.Printf("💡 Hints remaining: %d\n", maxHints-hintsUsed)
+				hintGiven := showUniqueRandomAttributeHint(target, game.HintsUsed+1, game.UsedHintAttributes, game.Config)
+				if !hintGiven {
+					fmt.Printf("❌ All available attributes have already been revealed - your %d remaining hint(s) can't be used.\n", game.hintsLeft())
+					continue // Don't count this as an attempt, go to next iteration
+				}
+				game.HintsUsed++
+				if game.hintsAvailable() == 0 && game.hintsLeft() > 0 {
+					fmt.Println("💡 That was the last attribute to reveal - no more hints are available.")
 				} else {
-					fmt.Printf("❌ All available attributes have already been revealed!\n")
+					fmt.Printf("💡 Hints remaining: %d\n", game.hintsLeft())
 				}
 				continue // Don't count this as an attempt, go to next iteration
 			}
 
+			// Compare two earlier guesses side by side, e.g. "diff Curry Tatum"
+			if fields := strings.Fields(guess); len(fields) > 0 && strings.ToLower(fields[0]) == "diff" {
+				if !game.Config.Assist {
+					fmt.Println("❌ Comparing guesses is only available with -assist.")
+					continue
+				}
+				first, second, err := parseDiffPlayers(game.History, fields[1:])
+				if err != nil {
+					fmt.Printf("❌ %v\n", err)
+					continue
+				}
+				printGuessDiff(first, second, game.Config)
+				continue
+			}
+
+			// The remaining commands never count as attempts either
+			switch strings.ToLower(guess) {
+			case "":
+				continue
+			case "help":
+				printCommands()
+				continue
+			case "list":
+				printPlayerList()
+				continue
+			case "legend":
+				printLegend(game.Config)
+				continue
+			case "info":
+				printInfo(game)
+				continue
+			case "time":
+				if err := game.tradeAttemptForTime(); err != nil {
+					fmt.Printf("❌ %v\n", err)
+					continue
+				}
+				fmt.Printf("⏳ Traded an attempt for %s: %d attempt(s) and %s left.\n",
+					formatDuration(game.Config.TimeTrade, game.Config), game.attemptsLeft(), formatTimeRemaining(game.timeRemaining()))
+				continue
+			case "suggest":
+				if !game.Config.Assist {
+					fmt.Println("❌ Suggestions are only available with -assist.")
+					continue
+				}
+				printSuggestion(game)
+				continue
+			case "skip":
+				previous := game.Target
+				if err := game.skipTarget(); err != nil {
+					fmt.Printf("❌ %v\n", err)
+					continue
+				}
+				target = game.Target
+				rememberRandomTarget(&game.Config, target)
+				if missing := missingAttributes(previous); len(missing) > 0 {
+					fmt.Printf("⏭️  Skipped %s (missing: %s). A new mystery player has been chosen - the board, attempts, hints, and clock start over.\n", previous.Name, strings.Join(missing, ", "))
+				} else {
+					fmt.Printf("⏭️  Skipped %s. A new mystery player has been chosen - the board, attempts, hints, and clock start over.\n", previous.Name)
+				}
+				printGameIntro(game)
+				continue
+			case "giveup":
+				fmt.Printf("\n🏳️  You gave up after %d attempt(s).\n", game.Attempts)
+				revealOnLoss(game, input)
+				return OutcomeLost
+			}
+
 			// Search for the guessed player in the database (case-insensitive)
-			guessedPlayer, found := findPlayerByName(guess)
+			guessedPlayer, found := findPlayerByName(guess, game.Config)
+			if !found && looksLikeCommand(guess) {
+				// A single unknown word is more likely a command typo than a player
+				fmt.Printf("❓ '%s' isn't a player or a command. Type 'help' to see the commands.\n", guess)
+				continue
+			}
+			if !found && game.Config.Hardcore {
+				// Hardcore charges an attempt for names that aren't in the database
+				game.Attempts++
+				game.PaceStart = game.now() // A guess starts a new pace window
+				fmt.Printf("❌ Player '%s' not found - that costs an attempt in hardcore mode.\n", guess)
+				if game.attemptsLeft() <= 0 {
+					fmt.Printf("\n💔 Game Over! You've used all %d attempts in %s.\n", game.Config.MaxAttempts, formatDuration(game.elapsed(), game.Config))
+					revealOnLoss(game, input)
+					return OutcomeLost
+				}
+				continue
+			}
 			if !found {
 				// Player not found in database - show error and continue without counting attempt
 				fmt.Printf("❌ Player '%s' not found. Please check the spelling.\n", guess)
-				fmt.Printf("💡 Tip: Names are case-insensitive. Type 'hint' to get a clue (%d hints remaining)\n", maxHints-hintsUsed)
+				if game.hintsAvailable() > 0 {
+					fmt.Printf("💡 Tip: Names are case-insensitive. Type 'hint' to get a clue (%d hints remaining)\n", game.hintsAvailable())
+				} else {
+					fmt.Println("💡 Tip: Names are case-insensitive.")
+				}
 				continue // Don't increment attempts counter
 			}
 
-			// Increment attempts counter since we have a valid guess
-			attempts++
+			// Candidate games only accept the listed players
+			if !isCandidate(*guessedPlayer, game.Config) {
+				fmt.Printf("❌ %s isn't one of the candidates - no attempt used.\n", guessedPlayer.Name)
+				continue
+			}
+
+			// Warn before spending an attempt on a player that was already guessed
+			if !game.Config.AllowRepeats && game.hasGuessed(*guessedPlayer) {
+				fmt.Printf("⚠️  You already guessed %s — that won't give new info. Guess anyway? (y/n): ", guessedPlayer.Name)
+				answer, ok := waitForInput(input, game.timeout())
+				if !ok || !isYes(answer) {
+					fmt.Println("Guess skipped - no attempt used.")
+					continue // Don't count this as an attempt
+				}
+			}
+
+			// Count the guess, compare it with the target, and display results
+			game.recordGuess(*guessedPlayer)
+			game.printRecord(game.History[len(game.History)-1]) // Print the color-coded comparison results
+			if game.Config.Heat && !game.isCorrect(*guessedPlayer) {
+				fmt.Println(describeHeat(heatPercent(*guessedPlayer, target, game.Config)))
+			}
+			if game.Config.Delta && len(game.History) > 1 && !game.isCorrect(*guessedPlayer) {
+				// The first guess has nothing to compare with, so it gets no delta line
+				previous, latest := game.History[len(game.History)-2], game.History[len(game.History)-1]
+				fmt.Println("🔀 Since your last guess:", describeDeltas(resultDeltas(previous.Result, latest.Result), game.Config.theme()))
+			}
+			if game.Config.Assist && !game.isCorrect(*guessedPlayer) {
+				printAssist(game)
+			}
+			if game.Config.ShowRemaining && !game.isCorrect(*guessedPlayer) {
+				fmt.Printf("🔎 %d player(s) still possible\n", len(game.remainingCandidates()))
+			}
+			if game.Config.TimeSplits {
+				splits := game.splits()
+				fmt.Printf("⏱️  This guess took %s (average %s per guess)\n", formatSplit(splits[len(splits)-1]), formatSplit(game.averageSplit()))
+			}
 
-			// Compare the guessed player with the target player and display results
-			result := compareWithTarget(*guessedPlayer, target)
-			fmt.Println(result) // Print the color-coded comparison results
+			// Cheer or heckle based on how this guess compares with earlier ones
+			if !game.Config.Quiet && !game.isCorrect(*guessedPlayer) {
+				if message := guessFeedbackMessage(game.History, game.Config.rng()); message != "" {
+					fmt.Println(message)
+				}
+			}
 
 			// Check if the guess is correct (case-insensitive name match)
-			if strings.ToLower(guessedPlayer.Name) == strings.ToLower(target.Name) {
+			if game.isCorrect(*guessedPlayer) {
 				// Player guessed correctly - show victory message and exit
-				elapsedTime := time.Now().Sub(gameStartTime)
-				fmt.Printf("\n🎉 CONGRATULATIONS! 🎉\n")
-				fmt.Printf("You guessed correctly in %d attempts and %s!\n", attempts, formatDuration(elapsedTime))
-				if hintsUsed > 0 {
-					fmt.Printf("You used %d hint(s) to help you.\n", hintsUsed)
-				}
-				fmt.Printf("The mystery player was: %s\n", target.Name)
-				printPlayerDetails(target) // Show detailed information about the target player
-				return                     // Exit the program
+				printVictory(game)
+				return OutcomeWon
 			}
 
 			// Check if player has used all attempts
-			if attempts == maxAttempts {
+			if game.attemptsLeft() <= 0 {
 				// Game over - show failure message and reveal answer
-				elapsedTime := time.Now().Sub(gameStartTime)
-				fmt.Printf("\n💔 Game Over! You've used all %d attempts in %s.\n", maxAttempts, formatDuration(elapsedTime))
-				fmt.Printf("The mystery player was: %s\n", target.Name)
-				printPlayerDetails(target) // Show detailed information about the target player
-				return                     // Exit the program
+				elapsedTime := game.elapsed()
+				fmt.Printf("\n💔 Game Over! You've used all %d attempts in %s.\n", game.Config.MaxAttempts, formatDuration(elapsedTime, game.Config))
+				revealOnLoss(game, input) // Show detailed information about the target player
+				return OutcomeLost
 			}
 
-			// Provide name hints at specific attempts
-			if attempts == 4 {
-				// After 4 attempts, reveal first letter of first name and last name
-				nameHint := getNameHint(target.Name, 1) // Get first letter hint
-				fmt.Printf("💡 Hint: The player's name starts with: %s\n", nameHint)
-			} else if attempts == 6 {
-				// After 6 attempts, reveal more of the player's name
-				nameHint := getNameHint(target.Name, 2) // Get more detailed name hint
-				fmt.Printf("💡 Hint: The player's name pattern: %s\n", nameHint)
+			// Provide name hints at the attempts scheduled by the difficulty
+			if level, due := game.dueNameHint(); due {
+				nameHint := getNameHint(target.Name, level)
+				if level == 1 {
+					fmt.Printf("💡 Hint: The player's name starts with: %s\n", nameHint)
+				} else if level == 2 {
+					fmt.Printf("💡 Hint: The player's name pattern: %s\n", nameHint)
+				} else {
+					fmt.Printf("💡 Last-chance hint: The player's name with its vowels: %s\n", nameHint)
+				}
 			}
 
-		case <-time.After(time.Until(gameEndTime)):
+		case <-game.paceTimeout():
+			// Too slow for -pace: forfeit a hint or an attempt, but keep playing
+			fmt.Printf("\n🐢 Too slow! No guess within %s - %s.\n", game.Config.Pace, game.applyPacePenalty())
+
+		case <-game.timeout():
 			// Time ran out while waiting for input
 			fmt.Printf("\n⏰ TIME'S UP! You ran out of time.\n")
-			fmt.Printf("The mystery player was: %s\n", target.Name)
-			printPlayerDetails(target)
+			return endOnTimeUp(game, input)
+		}
+	}
+
+	// Only reachable when a pace penalty used the last attempt: guesses and hardcore misses end
+	// the game where they're charged, time trades never spend the last attempt, and a save with
+	// no attempts left can't be resumed
+	fmt.Printf("\n💔 Game Over! You've used all %d attempts.\n", game.Config.MaxAttempts)
+	revealOnLoss(game, input)
+	return OutcomeLost
+}
+
+// quitAndSave ends a game on 'quit', saving it so it can be continued later with -resume
+func quitAndSave(game *Game) GameOutcome {
+	// An endurance run can't be resumed, since its clock keeps running
+	kind, answer := mysteryAnswer(game)
+	if game.Config.Endurance > 0 {
+		fmt.Printf("Thanks for playing! The mystery %s was: %s\n", kind, answer)
+		return OutcomeQuit
+	}
+	if err := saveGame(game, game.Config.SaveFile); err != nil {
+		fmt.Printf("\n❌ Could not save game: %v\n", err)
+		fmt.Printf("Thanks for playing! The mystery %s was: %s\n", kind, answer)
+		return OutcomeQuit
+	}
+	fmt.Printf("\n💾 Game saved to %s. Run with -resume to continue.\n", game.Config.SaveFile)
+	fmt.Println("Thanks for playing!")
+	return OutcomeQuit
+}
+
+// printTimeSplits lists how long each guess took, with the average and the total game time
+func printTimeSplits(game *Game) {
+	if len(game.History) == 0 {
+		return
+	}
+	fmt.Println("\n⏱️  Time splits:")
+	for i, split := range game.splits() {
+		fmt.Printf("  %d. %-25s %s\n", i+1, game.History[i].Player.Name, formatSplit(split))
+	}
+	fmt.Printf("  Average per guess: %s - Total: %s\n", formatSplit(game.averageSplit()), formatSplit(game.elapsed()))
+}
+
+// printVictory shows the victory message and the mystery player's details
+func printVictory(game *Game) {
+	elapsedTime := game.elapsed()
+	fmt.Printf("\n🎉 CONGRATULATIONS! 🎉\n")
+	fmt.Printf("You guessed correctly in %d attempts and %s!\n", game.Attempts, formatDuration(elapsedTime, game.Config))
+	if !game.Config.Quiet {
+		fmt.Println(finishMessage(game.Attempts))
+	}
+	if game.HintsUsed > 0 {
+		fmt.Printf("You used %d hint(s) to help you.\n", game.HintsUsed)
+	}
+	fmt.Printf("The mystery player was: %s\n", game.Target.Name)
+	showPlayerReveal(game.Target, game.Config) // Show detailed information about the target player
+	game.AnswerShown = true
+}
+
+// endOnTimeUp ends a game whose clock ran out as a loss that reveals the answer, except in
+// async games, which must never spoil on a timer - those are saved to be resumed instead
+func endOnTimeUp(game *Game, input <-chan string) GameOutcome {
+	if !game.Config.Async {
+		revealOnLoss(game, input)
+		return OutcomeTimeUp
+	}
+	if err := saveGame(game, game.Config.SaveFile); err != nil {
+		fmt.Printf("❌ Could not save game: %v\n", err)
+		revealOnLoss(game, input) // Still a no-spoil reveal, since async turns -no-spoil on
+		return OutcomeTimeUp
+	}
+	fmt.Printf("💾 Async game saved to %s without revealing the answer. Run with -resume to continue.\n", game.Config.SaveFile)
+	return OutcomeQuit
+}
+
+// mysteryAnswer returns what the game asks for and its answer: the mystery team in team mode,
+// otherwise the mystery player
+func mysteryAnswer(game *Game) (string, string) {
+	if game.Config.Mode == "team" {
+		return "team", mysteryTeamFor(game.Target).Name
+	}
+	return "player", game.Target.Name
+}
+
+// showAnswer reveals the mystery player with their details, or the mystery team in team mode
+func showAnswer(game *Game) {
+	kind, answer := mysteryAnswer(game)
+	fmt.Printf("The mystery %s was: %s\n", kind, answer)
+	if kind == "player" {
+		showPlayerReveal(game.Target, game.Config)
+	}
+	game.AnswerShown = true
+}
+
+// revealOnLoss shows the answer after a loss, or in no-spoil mode waits until the player asks for it
+func revealOnLoss(game *Game, input <-chan string) {
+	// Point out the gaps in what the player found out, without giving anything away; team
+	// games never compare player attributes, so they have no gaps to point out
+	if unlearned := game.unlearnedAttributes(); len(unlearned) > 0 && game.Config.Mode != "team" {
+		labels := make([]string, len(unlearned))
+		for i, attribute := range unlearned {
+			labels[i] = attributeDisplayNames[attribute]
+		}
+		fmt.Printf("📚 You never learned: %s\n", strings.Join(labels, ", "))
+	}
+
+	if !game.Config.NoSpoil {
+		showAnswer(game)
+		return
+	}
+
+	fmt.Println("Type 'reveal' to see the answer or 'quit' to leave without spoiling.")
+	for {
+		fmt.Print("> ")
+		command, ok := <-input
+		if !ok {
+			return // Input ended - leave the answer hidden
+		}
+		switch strings.ToLower(strings.TrimSpace(command)) {
+		case "reveal":
+			showAnswer(game)
+			return
+		case "quit":
+			fmt.Println("No spoilers - come back and try again!")
 			return
+		default:
+			fmt.Println("Type 'reveal' to see the answer or 'quit' to leave without spoiling.")
 		}
 	}
 }
 
-// formatTimeRemaining formats the remaining time in a user-friendly way
-func formatTimeRemaining(duration time.Duration) string {
+// Duration styles: verbose spells the units out in the locale ("3 minutes 12 seconds"), clock is
+// the countdown style ("3m 12s"), and compact drops the space ("3m12s")
+const (
+	durationVerbose = "verbose"
+	durationClock   = "clock"
+	durationCompact = "compact"
+)
+
+// formatDurationStyle formats a duration to the second in a style; under a minute, only the
+// seconds are shown ("12 seconds", "12s")
+func formatDurationStyle(duration time.Duration, style string) string {
 	minutes := int(duration.Minutes())
 	seconds := int(duration.Seconds()) % 60
 
-	if minutes > 0 {
-		return fmt.Sprintf("%dm %ds", minutes, seconds)
+	switch style {
+	case durationClock, durationCompact:
+		if minutes == 0 {
+			return fmt.Sprintf("%ds", seconds)
+		}
+		separator := " "
+		if style == durationCompact {
+			separator = ""
+		}
+		return fmt.Sprintf("%dm%s%ds", minutes, separator, seconds)
+	}
+	if minutes == 0 {
+		return fmt.Sprintf("%d %s", seconds, unit(seconds, currentLocale.Second, currentLocale.Seconds))
 	}
-	return fmt.Sprintf("%ds", seconds)
+	return fmt.Sprintf("%d %s %d %s", minutes, unit(minutes, currentLocale.Minute, currentLocale.Minutes),
+		seconds, unit(seconds, currentLocale.Second, currentLocale.Seconds))
 }
 
-// formatDuration formats elapsed time in a user-friendly way
-func formatDuration(duration time.Duration) string {
-	minutes := int(duration.Minutes())
-	seconds := int(duration.Seconds()) % 60
+// formatTimeRemaining formats a countdown, e.g. "5m 59s"
+func formatTimeRemaining(duration time.Duration) string {
+	return formatDurationStyle(duration, durationClock)
+}
 
+// formatDuration formats elapsed time for messages and summaries in the -durations style;
+// countdowns always use the clock style
+func formatDuration(duration time.Duration, config GameConfig) string {
+	return formatDurationStyle(duration, config.Durations)
+}
+
+// formatSplit formats a guess split to a tenth of a second, e.g. "12.3s" or "1m 04.2s"
+func formatSplit(duration time.Duration) string {
+	minutes := int(duration.Minutes())
+	seconds := duration.Seconds() - float64(minutes*60)
 	if minutes > 0 {
-		return fmt.Sprintf("%d minutes %d seconds", minutes, seconds)
+		return fmt.Sprintf("%dm %04.1fs", minutes, seconds)
 	}
-	return fmt.Sprintf("%d seconds", seconds)
+	return fmt.Sprintf("%.1fs", seconds)
 }
 
+// hintAttributes lists every attribute a 'hint' can reveal
+var hintAttributes = []string{"team", "position", "height", "weight", "college", "draftyear", "draftround", "draftnumber", "drafttier", "jerseynumber", "country"}
+
+// starterClueAttributes lists the weak attributes a free starter clue can reveal
+var starterClueAttributes = []string{"country", "position", "drafttier"}
+
 // showUniqueRandomAttributeHint displays a unique random attribute of the target player
 // Returns true if a hint was given, false if all attributes have been used
-func showUniqueRandomAttributeHint(target Player, hintNumber int, usedAttributes map[string]bool) bool {
-	// List of all available attributes to hint about
-	allAttributes := []string{"team", "position", "height", "college", "draftyear", "draftround", "draftnumber", "jerseynumber", "country"}
+func showUniqueRandomAttributeHint(target Player, hintNumber int, usedAttributes map[string]bool, config GameConfig) bool {
+	// Climb the ladder in order when configured, then pick at random once it's used up,
+	// passing over attributes that -hint-min-shared considers too decisive
+	selectedAttribute, found := "", false
+	if config.HintOrder == "ladder" {
+		selectedAttribute, found = nextLadderAttribute(nonDecisiveAttributes(target, config.HintLadder, config), usedAttributes)
+	}
+	if !found {
+		selectedAttribute, found = pickUnusedAttribute(nonDecisiveAttributes(target, hintAttributes, config), usedAttributes, config.rng())
+	}
+	if !found && config.HintMinShared > 0 {
+		// Only decisive attributes are left; revealing one beats wasting the hint, but say so
+		selectedAttribute, found = pickUnusedAttribute(hintAttributes, usedAttributes, config.rng())
+		if found {
+			fmt.Printf("⚠️  Every remaining hint is shared by fewer than %d players, so this one gives a lot away.\n", config.HintMinShared)
+		}
+	}
+	if !found {
+		return false // No more unique attributes available
+	}
+
+	fmt.Printf("💡 Hint #%d: %s\n", hintNumber, describeAttribute(target, selectedAttribute, config))
+	return true // Hint was successfully given
+}
+
+// showRequestedAttributeHint reveals the attribute the player asked for, e.g. "hint college"
+// Returns an error without revealing anything if the attribute is unknown or already revealed
+func showRequestedAttributeHint(target Player, hintNumber int, requested string, usedAttributes map[string]bool, config GameConfig) error {
+	attribute, known := attributeAliases[requested]
+	if !known && isHintAttribute(strings.ReplaceAll(requested, " ", "")) {
+		attribute, known = strings.ReplaceAll(requested, " ", ""), true // e.g. "draft tier" or "continent"
+	}
+	if !known {
+		return fmt.Errorf("unknown attribute '%s' - try team, position, height, weight, college, draft year, round, pick, draft tier, jersey, jersey range, or country", requested)
+	}
+	if usedAttributes[attribute] {
+		return fmt.Errorf("%s has already been revealed - pick another attribute", requested)
+	}
+	if isDecisiveHint(target, attribute, config) {
+		return fmt.Errorf("%s is shared by fewer than %d players, which would give the answer away - pick another attribute", requested, config.HintMinShared)
+	}
+
+	usedAttributes[attribute] = true
+	fmt.Printf("💡 Hint #%d: %s\n", hintNumber, describeAttribute(target, attribute, config))
+	return nil
+}
+
+// rememberRandomTarget records a random mystery player for -avoid-recent, warning if the stats file can't be written
+func rememberRandomTarget(config *GameConfig, target Player) {
+	if config.AvoidRecent == 0 {
+		return
+	}
+	if err := rememberTarget(config, target); err != nil {
+		fmt.Printf("Warning: could not record the mystery player as recent: %v\n", err)
+	}
+}
 
+// showStarterClue reveals one weak attribute for free at the start of a game without using
+// the hint budget; a resumed game repeats the clue it started with
+func showStarterClue(game *Game) {
+	if game.StarterClue == "" {
+		attribute, found := pickUnusedAttribute(starterClueAttributes, game.UsedHintAttributes, game.Config.rng())
+		if !found {
+			return // Every weak attribute is already known
+		}
+		game.StarterClue = attribute
+	}
+	fmt.Printf("🎁 Starter clue: %s\n", describeAttribute(game.Target, game.StarterClue, game.Config))
+}
+
+// pickUnusedAttribute selects a random attribute from candidates that hasn't been used yet
+// and marks it as used
+func pickUnusedAttribute(candidates []string, usedAttributes map[string]bool, rng *rand.Rand) (string, bool) {
 	// Filter out already used attributes
 	var availableAttributes []string
-	for _, attr := range allAttributes {
+	for _, attr := range candidates {
 		if !usedAttributes[attr] {
 			availableAttributes = append(availableAttributes, attr)
 		}
@@ -206,61 +944,85 @@ func showUniqueRandomAttributeHint(target Player, hintNumber int, usedAttributes
 
 	// Check if any attributes are still available
 	if len(availableAttributes) == 0 {
-		return false // No more unique attributes available
+		return "", false
 	}
 
-	// Seed random number generator
-	rand.Seed(time.Now().UnixNano())
-
 	// Select a random attribute from available ones
-	selectedAttribute := availableAttributes[rand.Intn(len(availableAttributes))]
+	selectedAttribute := availableAttributes[rng.Intn(len(availableAttributes))]
 
 	// Mark this attribute as used
 	usedAttributes[selectedAttribute] = true
+	return selectedAttribute, true
+}
 
-	fmt.Printf("💡 Hint #%d: ", hintNumber)
-
-	switch selectedAttribute {
+// describeAttribute returns a sentence revealing one attribute of the target player
+func describeAttribute(target Player, attribute string, config GameConfig) string {
+	switch attribute {
 	case "team":
-		fmt.Printf("The player's current team is: %s\n", target.Team)
+		// -team-art shows the logo instead of the name where there is art for it
+		if art, found := teamSilhouette(comparedTeam(target, config)); found && config.TeamArt {
+			return "The player's team has this logo:\n     " + strings.Join(art, "\n     ")
+		}
+		if config.TeamMode == "iconic" && target.IconicTeam != "" {
+			return fmt.Sprintf("The player is best known for playing with: %s", target.IconicTeam)
+		} else {
+			return fmt.Sprintf("The player's current team is: %s", target.Team)
+		}
 	case "position":
-		fmt.Printf("The player's position is: %s\n", target.Position)
+		return fmt.Sprintf("The player's position is: %s", target.Position)
 	case "height":
-		fmt.Printf("The player's height is: %s\n", target.Height)
+		return fmt.Sprintf("The player's height is: %s", target.Height)
+	case "weight":
+		if target.Weight == 0 {
+			return "The player's weight is not available"
+		} else {
+			return fmt.Sprintf("The player weighs: %d lbs", target.Weight)
+		}
 	case "college":
 		if target.College == "None" || target.College == "Unknown" {
-			fmt.Printf("The player did not attend college (international or straight from high school)\n")
+			return "The player did not attend college (international or straight from high school)"
 		} else {
-			fmt.Printf("The player attended: %s\n", target.College)
+			return fmt.Sprintf("The player attended: %s", target.College)
 		}
 	case "draftyear":
-		fmt.Printf("The player was drafted in: %d\n", target.DraftYear)
+		if target.DraftYear == unknownDraftYear {
+			return "The player's draft year is not available"
+		} else {
+			return fmt.Sprintf("The player was drafted in: %d", target.DraftYear)
+		}
 	case "draftround":
 		if target.DraftRound == 0 {
-			fmt.Printf("The player was undrafted\n")
+			return "The player was undrafted"
 		} else {
-			fmt.Printf("The player was drafted in round: %d\n", target.DraftRound)
+			return fmt.Sprintf("The player was drafted in round: %d", target.DraftRound)
 		}
 	case "draftnumber":
 		if target.DraftNumber == 0 {
-			fmt.Printf("The player was undrafted (no draft pick number)\n")
+			return "The player was undrafted (no draft pick number)"
+		} else {
+			return fmt.Sprintf("The player was the #%d overall pick", target.DraftNumber)
+		}
+	case "drafttier":
+		if target.DraftNumber == 0 {
+			return "The player went undrafted"
 		} else {
-			fmt.Printf("The player was the #%d overall pick\n", target.DraftNumber)
+			return fmt.Sprintf("The player was a %s pick", draftTier(target.DraftNumber))
 		}
 	case "jerseynumber":
 		if target.JerseyNumber == "Unknown" {
-			fmt.Printf("The player's jersey number is not available\n")
+			return "The player's jersey number is not available"
 		} else {
-			fmt.Printf("The player's jersey number is: #%s\n", target.JerseyNumber)
+			return fmt.Sprintf("The player's jersey number is: #%s", target.JerseyNumber)
 		}
 	case "country":
-		fmt.Printf("The player is from: %s\n", target.Country)
+		return fmt.Sprintf("The player is from: %s", target.Country)
 	}
 
-	return true // Hint was successfully given
+	return describeLadderAttribute(target, attribute, config)
 }
 
 // getNameHint returns a partial hint of the player's name based on the hint level
+// Apostrophes and hyphens stay visible and are not counted as letters
 func getNameHint(fullName string, hintLevel int) string {
 	// Split the name into parts (first name, last name, etc.)
 	nameParts := strings.Fields(fullName)
@@ -269,80 +1031,88 @@ func getNameHint(fullName string, hintLevel int) string {
 		return "Unknown"
 	}
 
-	switch hintLevel {
-	case 1:
-		// Level 1: Show first letter of each name part
-		var hints []string
-		for _, part := range nameParts {
-			if len(part) > 0 {
-				hints = append(hints, string(part[0])+"_")
+	var hints []string
+	for _, part := range nameParts {
+		switch hintLevel {
+		case 2:
+			// Level 2: Show first 1-3 letters of each name part and its letter count
+			letters := countLetters(part)
+			shown := 3
+			if letters <= 3 {
+				shown = 1 // For very short names, show first letter only
+			} else if letters <= 5 {
+				shown = 2 // For short names, show first 2 letters
 			}
-		}
-		return strings.Join(hints, " ")
+			hints = append(hints, fmt.Sprintf("%s (%d letters)", maskNamePart(part, shown), letters))
 
-	case 2:
-		// Level 2: Show first 2-3 letters of each name part and length
-		var hints []string
-		for _, part := range nameParts {
-			if len(part) == 0 {
-				continue
+		case 3:
+			// Level 3: Show the first letter and every vowel of each name part, hiding the other consonants
+			runes := []rune(part)
+			var hint strings.Builder
+			for i, r := range runes {
+				if i == 0 || len(runes) == 1 || strings.ContainsRune("aeiouyAEIOUY", r) || !unicode.IsLetter(r) {
+					hint.WriteRune(r) // Keep punctuation like apostrophes and hyphens visible
+				} else {
+					hint.WriteRune('_')
+				}
 			}
+			hints = append(hints, hint.String())
 
-			var hint string
-			if len(part) <= 3 {
-				// For very short names, show first letter only
-				hint = string(part[0]) + strings.Repeat("_", len(part)-1)
-			} else if len(part) <= 5 {
-				// For short names, show first 2 letters
-				hint = part[:2] + strings.Repeat("_", len(part)-2)
-			} else {
-				// For longer names, show first 3 letters
-				hint = part[:3] + strings.Repeat("_", len(part)-3)
+		default:
+			// Level 1 (and default): Show the first letter of each name part, and of each half of a hyphenated part
+			segments := strings.Split(part, "-")
+			for i, segment := range segments {
+				if runes := []rune(segment); len(runes) > 0 {
+					segments[i] = string(runes[0]) + "_"
+				}
 			}
+			hints = append(hints, strings.Join(segments, "-"))
+		}
+	}
+	return strings.Join(hints, " ")
+}
 
-			// Add length indicator
-			hint += fmt.Sprintf(" (%d letters)", len(part))
-			hints = append(hints, hint)
+// countLetters counts the letters in a name part, ignoring apostrophes, hyphens, and periods
+func countLetters(part string) int {
+	count := 0
+	for _, r := range part {
+		if unicode.IsLetter(r) {
+			count++
 		}
-		return strings.Join(hints, " ")
+	}
+	return count
+}
 
-	default:
-		// Default case: just show first letters
-		var hints []string
-		for _, part := range nameParts {
-			if len(part) > 0 {
-				hints = append(hints, string(part[0])+"_")
-			}
+// maskNamePart shows the first shown letters of a name part and replaces the remaining
+// letters with underscores, leaving punctuation in place
+func maskNamePart(part string, shown int) string {
+	var masked strings.Builder
+	letters := 0
+	for _, r := range part {
+		if !unicode.IsLetter(r) {
+			masked.WriteRune(r)
+			continue
+		}
+		letters++
+		if letters <= shown {
+			masked.WriteRune(r)
+		} else {
+			masked.WriteRune('_')
 		}
-		return strings.Join(hints, " ")
 	}
+	return masked.String()
 }
 
 // printPlayerDetails displays comprehensive information about a player
-func printPlayerDetails(player Player) {
+func printPlayerDetails(player Player, config GameConfig) {
 	// Print decorative separator line
 	fmt.Println("\n" + strings.Repeat("-", 50))
 
-	// Display basic player information
-	fmt.Printf("Name: %s\n", player.Name)
-	fmt.Printf("Team: %s\n", player.Team)
-	fmt.Printf("Position: %s\n", player.Position)
-	fmt.Printf("Height: %s\n", player.Height)
-	fmt.Printf("College: %s\n", player.College)
-	fmt.Printf("Draft Year: %d\n", player.DraftYear)
-
-	// Display draft information
-	if player.DraftRound == 0 {
-		fmt.Printf("Draft Status: Undrafted\n")
-	} else {
-		fmt.Printf("Draft Round: %d\n", player.DraftRound)
-		fmt.Printf("Draft Pick: %d\n", player.DraftNumber)
+	// Display every detail in the configured reveal order
+	for _, line := range revealLines(orderReveal(detailSections(player), config)) {
+		fmt.Println(line)
 	}
 
-	// Display jersey number and country
-	fmt.Printf("Jersey Number: %s\n", player.JerseyNumber)
-	fmt.Printf("Country: %s\n", player.Country)
-
 	// Print closing decorative separator line
 	fmt.Println(strings.Repeat("-", 50))
 }
@@ -0,0 +1,396 @@
+package main
+
+import (
+	"math/rand"     // Package for a seeded random source
+	"os"            // Package for checking the save file
+	"path/filepath" // Package for building file paths
+	"slices"        // Package for searching slices
+	"strings"       // Package for string manipulation functions
+	"testing"       // Package for Go tests
+	"time"          // Package for time-related functions
+)
+
+// TestFormatDurationStyle checks both -durations styles and the clock style countdowns use
+func TestFormatDurationStyle(t *testing.T) {
+	tests := []struct {
+		duration time.Duration
+		style    string
+		want     string
+	}{
+		{0, durationVerbose, "0 seconds"},
+		{time.Second, durationVerbose, "1 second"},
+		{42 * time.Second, durationVerbose, "42 seconds"},
+		{time.Minute, durationVerbose, "1 minute 0 seconds"},
+		{3*time.Minute + 12*time.Second, durationVerbose, "3 minutes 12 seconds"},
+		{42 * time.Second, durationCompact, "42s"},
+		{time.Minute, durationCompact, "1m0s"},
+		{3*time.Minute + 12*time.Second, durationCompact, "3m12s"},
+		{59*time.Second + 900*time.Millisecond, durationCompact, "59s"}, // Truncated, never rounded up
+		{5*time.Minute + 59*time.Second, durationClock, "5m 59s"},
+		{9 * time.Second, durationClock, "9s"},
+	}
+	for _, test := range tests {
+		if got := formatDurationStyle(test.duration, test.style); got != test.want {
+			t.Errorf("formatDurationStyle(%v, %q) = %q, want %q", test.duration, test.style, got, test.want)
+		}
+	}
+}
+
+// TestFormatDurationUsesConfig checks that messages follow each config's -durations setting
+func TestFormatDurationUsesConfig(t *testing.T) {
+	verbose, compact := defaultConfig(), defaultConfig()
+	compact.Durations = durationCompact
+	if got := formatDuration(90*time.Second, verbose); got != "1 minute 30 seconds" {
+		t.Errorf("verbose config gave %q", got)
+	}
+	if got := formatDuration(90*time.Second, compact); got != "1m30s" {
+		t.Errorf("compact config gave %q", got)
+	}
+}
+
+// TestFormatSplit checks splits to a tenth of a second, with minutes once there are any
+func TestFormatSplit(t *testing.T) {
+	tests := map[time.Duration]string{
+		12*time.Second + 340*time.Millisecond: "12.3s",
+		64*time.Second + 200*time.Millisecond: "1m 04.2s",
+	}
+	for split, want := range tests {
+		if got := formatSplit(split); got != want {
+			t.Errorf("formatSplit(%v) = %q, want %q", split, got, want)
+		}
+	}
+}
+
+// playLines plays a game with the given input lines, which end as if the player quit
+func playLines(game *Game, lines ...string) GameOutcome {
+	return playGame(game, inputLines(lines...))
+}
+
+// inputLines returns an input channel that yields the given lines and then ends
+func inputLines(lines ...string) <-chan string {
+	input := make(chan string, len(lines))
+	for _, line := range lines {
+		input <- line
+	}
+	close(input)
+	return input
+}
+
+// TestHardcoreChargesUnknownNames checks that hardcore charges for names that aren't in the
+// pool, ending the game on the spot when that was the last attempt
+func TestHardcoreChargesUnknownNames(t *testing.T) {
+	useFallbackPlayers(t)
+	game, _ := newTestGame(t, func(c *GameConfig) {
+		c.Hardcore = true
+		c.MaxAttempts = 2
+		c.SaveFile = t.TempDir() + "/save.json"
+	})
+	outcome := playLines(game, "Nobody Atall", "Nobody Else")
+	if outcome != OutcomeLost || game.Attempts != 2 {
+		t.Errorf("two unknown names in a two-attempt hardcore game: %v with %d attempts, want a loss with 2", outcome, game.Attempts)
+	}
+	if !game.AnswerShown {
+		t.Error("the game ended on a hardcore miss without revealing the answer")
+	}
+}
+
+// TestHardcoreForgivesCommandTypos checks that a single unknown word is taken for a mistyped
+// command and costs nothing, in hardcore as in normal play
+func TestHardcoreForgivesCommandTypos(t *testing.T) {
+	useFallbackPlayers(t)
+	for _, hardcore := range []bool{true, false} {
+		game, _ := newTestGame(t, func(c *GameConfig) {
+			c.Hardcore = hardcore
+			c.SaveFile = t.TempDir() + "/save.json"
+		})
+		if outcome := playLines(game, "hnt", "lisst", "Nobody Atall"); outcome != OutcomeQuit {
+			t.Fatalf("hardcore=%v: playGame() = %v, want OutcomeQuit at the end of input", hardcore, outcome)
+		}
+		want := 0
+		if hardcore {
+			want = 1 // Only the full name is charged
+		}
+		if game.Attempts != want {
+			t.Errorf("hardcore=%v: %d attempts charged, want %d", hardcore, game.Attempts, want)
+		}
+	}
+}
+
+// TestRepeatGuesses checks that guessing a player again asks first, and that only a yes spends
+// an attempt, unless -allow-repeats skips the question
+func TestRepeatGuesses(t *testing.T) {
+	useFallbackPlayers(t)
+	tests := []struct {
+		name         string
+		allowRepeats bool
+		lines        []string
+		want         int
+	}{
+		{"declined", false, []string{"Michael Jordan", "Michael Jordan", "n"}, 1},
+		{"confirmed", false, []string{"Michael Jordan", "Michael Jordan", "y"}, 2},
+		{"allowed", true, []string{"Michael Jordan", "Michael Jordan"}, 2},
+		{"same player, other spelling", false, []string{"Michael Jordan", "MJ", "n"}, 1},
+	}
+	for _, test := range tests {
+		game, _ := newTestGame(t, func(c *GameConfig) {
+			c.AllowRepeats = test.allowRepeats
+			c.SaveFile = t.TempDir() + "/save.json"
+		})
+		game.Target = getFallbackPlayers()[0] // LeBron James
+		playLines(game, test.lines...)
+		if game.Attempts != test.want {
+			t.Errorf("%s: %d attempts used, want %d", test.name, game.Attempts, test.want)
+		}
+	}
+}
+
+// TestHasGuessed checks that a repeat is recognized by identity, not by the way it was typed
+func TestHasGuessed(t *testing.T) {
+	game, _ := newTestGame(t, nil)
+	jordan := getFallbackPlayers()[1]
+	if game.hasGuessed(jordan) {
+		t.Fatal("a fresh game has already guessed Michael Jordan")
+	}
+	game.recordGuess(jordan)
+	if !game.hasGuessed(jordan) {
+		t.Error("Michael Jordan wasn't recognized as guessed")
+	}
+	namesake := jordan
+	namesake.ID = 99 // Same name, but a different API player
+	if game.hasGuessed(namesake) {
+		t.Error("a different player with the same name counted as a repeat")
+	}
+}
+
+// TestRevealOnLossIsGated checks that -no-spoil keeps the answer hidden until 'reveal' is typed
+func TestRevealOnLossIsGated(t *testing.T) {
+	tests := []struct {
+		name    string
+		noSpoil bool
+		lines   []string
+		want    bool
+	}{
+		{"spoilers on", false, nil, true},
+		{"reveal asked for", true, []string{"reveal"}, true},
+		{"other input first", true, []string{"hint", "REVEAL"}, true},
+		{"quit", true, []string{"quit", "reveal"}, false},
+		{"input ends", true, nil, false},
+	}
+	for _, test := range tests {
+		game, _ := newTestGame(t, func(c *GameConfig) { c.NoSpoil = test.noSpoil })
+		revealOnLoss(game, inputLines(test.lines...))
+		if game.AnswerShown != test.want {
+			t.Errorf("%s: answer shown = %v, want %v", test.name, game.AnswerShown, test.want)
+		}
+	}
+}
+
+// TestNameHintLevel3 checks the level-3 mask on several name shapes: the first letter and
+// the vowels of each part show, other letters are hidden, and punctuation stays visible
+func TestNameHintLevel3(t *testing.T) {
+	tests := map[string]string{
+		"LeBron James":            "Le__o_ Ja_e_",
+		"Al Horford":              "A_ Ho__o__",
+		"De'Aaron Fox":            "De'Aa_o_ Fo_",
+		"Shai Gilgeous-Alexander": "S_ai Gi__eou_-A_e_a__e_",
+		"O.J. Mayo":               "O._. Mayo",
+		"J Smith":                 "J S_i__",
+	}
+	for name, want := range tests {
+		if got := getNameHint(name, 3); got != want {
+			t.Errorf("getNameHint(%q, 3) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+// TestNameHintPunctuation checks that levels 1 and 2 keep apostrophes and hyphens in place
+// and leave them out of the letter counts
+func TestNameHintPunctuation(t *testing.T) {
+	tests := []struct {
+		name  string
+		level int
+		want  string
+	}{
+		{"De'Aaron Fox", 1, "D_ F_"},
+		{"Shai Gilgeous-Alexander", 1, "S_ G_-A_"},
+		{"De'Aaron Fox", 2, "De'A____ (7 letters) F__ (3 letters)"},
+		{"Shai Gilgeous-Alexander", 2, "Sh__ (4 letters) Gil_____-_________ (17 letters)"},
+		{"D'Angelo Russell", 2, "D'An____ (7 letters) Rus____ (7 letters)"},
+	}
+	for _, test := range tests {
+		if got := getNameHint(test.name, test.level); got != test.want {
+			t.Errorf("getNameHint(%q, %d) = %q, want %q", test.name, test.level, got, test.want)
+		}
+	}
+}
+
+// TestStarterClueMarksAttributeUsed checks that the starter clue marks its attribute as
+// revealed without spending a hint, so no later hint repeats it
+func TestStarterClueMarksAttributeUsed(t *testing.T) {
+	useFallbackPlayers(t)
+	game, _ := newTestGame(t, func(c *GameConfig) {
+		c.StarterClue = true
+		c.Rand = rand.New(rand.NewSource(1))
+	})
+	showStarterClue(game)
+	clue := game.StarterClue
+	if !slices.Contains(starterClueAttributes, clue) {
+		t.Fatalf("starter clue %q isn't one of the weak attributes %v", clue, starterClueAttributes)
+	}
+	if !game.UsedHintAttributes[clue] || game.HintsUsed != 0 {
+		t.Fatalf("after the starter clue: %s used = %v, hints used = %d; want true, 0", clue, game.UsedHintAttributes[clue], game.HintsUsed)
+	}
+
+	given := 0
+	for showUniqueRandomAttributeHint(game.Target, given+1, game.UsedHintAttributes, game.Config) {
+		given++
+	}
+	if given != len(hintAttributes)-1 {
+		t.Errorf("%d hints were given after the starter clue, want %d (every attribute but %s)", given, len(hintAttributes)-1, clue)
+	}
+
+	// A resumed game repeats the clue it started with
+	showStarterClue(game)
+	if game.StarterClue != clue {
+		t.Errorf("showing the clue again changed it from %q to %q", clue, game.StarterClue)
+	}
+}
+
+// TestHintsAfterEveryAttributeRevealed checks that once hints have revealed every attribute,
+// further hint requests are refused without spending the hints left or an attempt
+func TestHintsAfterEveryAttributeRevealed(t *testing.T) {
+	useFallbackPlayers(t)
+	spare := 3
+	game, _ := newTestGame(t, func(c *GameConfig) {
+		c.MaxHints = len(hintAttributes) + spare
+		c.SaveFile = t.TempDir() + "/save.json"
+	})
+	var lines []string
+	for i := 0; i < len(hintAttributes)+2; i++ {
+		lines = append(lines, "hint")
+	}
+	playLines(game, append(lines, "hint team")...)
+
+	if game.HintsUsed != len(hintAttributes) || game.hintsLeft() != spare {
+		t.Errorf("hints used %d with %d left, want %d with %d", game.HintsUsed, game.hintsLeft(), len(hintAttributes), spare)
+	}
+	if game.hintsAvailable() != 0 {
+		t.Errorf("hintsAvailable() = %d with every attribute revealed, want 0", game.hintsAvailable())
+	}
+	if game.Attempts != 0 {
+		t.Errorf("refused hints used %d attempts", game.Attempts)
+	}
+}
+
+// TestGuessDispatch checks where each kind of input is routed: commands and unknown input never
+// use an attempt, player names do, and 'giveup' ends the game
+func TestGuessDispatch(t *testing.T) {
+	useFallbackPlayers(t)
+	miss := testGuess(newGame(defaultConfig(), getFallbackPlayers()[0]))
+	tests := []struct {
+		name         string
+		lines        []string
+		wantAttempts int
+		wantOutcome  GameOutcome
+	}{
+		{"commands", []string{"help", "HELP", "list", "legend", "info", "suggest", "time", "diff a b"}, 0, OutcomeQuit},
+		{"command typo", []string{"hnt", "sugest"}, 0, OutcomeQuit},
+		{"unknown name", []string{"Nobody Atall"}, 0, OutcomeQuit},
+		{"partial name", []string{miss.Name, "embiid"}, 2, OutcomeQuit},
+		{"player", []string{miss.Name}, 1, OutcomeQuit},
+		{"give up", []string{miss.Name, "giveup", miss.Name}, 1, OutcomeLost},
+		{"win", []string{"hint", getFallbackPlayers()[0].Name}, 1, OutcomeWon},
+	}
+	for _, test := range tests {
+		game, _ := newTestGame(t, func(c *GameConfig) { c.SaveFile = t.TempDir() + "/save.json" })
+		outcome := playLines(game, test.lines...)
+		if outcome != test.wantOutcome || game.Attempts != test.wantAttempts {
+			t.Errorf("%s: %v with %d attempts, want %v with %d", test.name, outcome, game.Attempts, test.wantOutcome, test.wantAttempts)
+		}
+	}
+}
+
+// TestRequestedAttributeHint checks targeted hints: aliases and spaced names are understood,
+// while unknown and already-revealed attributes are refused without revealing anything
+func TestRequestedAttributeHint(t *testing.T) {
+	useFallbackPlayers(t)
+	target := getFallbackPlayers()[0]
+	config := defaultConfig()
+	config.HintMinShared = 0
+	used := make(map[string]bool)
+
+	tests := []struct {
+		requested string
+		attribute string // Attribute revealed, "" when the request is refused
+		wantErr   string
+	}{
+		{"college", "college", ""},
+		{"pos", "position", ""},
+		{"draft tier", "drafttier", ""},
+		{"jersey range", "jerseyrange", ""},
+		{"shoe size", "", "unknown attribute 'shoe size'"},
+		{"college", "", "college has already been revealed"},
+		{"position", "", "position has already been revealed"},
+	}
+	for i, test := range tests {
+		before := len(used)
+		err := showRequestedAttributeHint(target, i+1, test.requested, used, config)
+		if test.wantErr != "" {
+			if err == nil || !strings.HasPrefix(err.Error(), test.wantErr) {
+				t.Errorf("hint %s: error %v, want %q", test.requested, err, test.wantErr)
+			}
+			if len(used) != before {
+				t.Errorf("refused hint %s still marked an attribute used", test.requested)
+			}
+			continue
+		}
+		if err != nil || !used[test.attribute] {
+			t.Errorf("hint %s: error %v, %s used = %v", test.requested, err, test.attribute, used[test.attribute])
+		}
+	}
+}
+
+// TestRequestedHintsThroughTheGame checks that refused targeted hints don't spend the hint budget
+func TestRequestedHintsThroughTheGame(t *testing.T) {
+	useFallbackPlayers(t)
+	game, _ := newTestGame(t, func(c *GameConfig) {
+		c.MaxHints = 3
+		c.HintMinShared = 0
+		c.SaveFile = t.TempDir() + "/save.json"
+	})
+	playLines(game, "hint college", "hint college", "hint nonsense", "hint team")
+	if game.HintsUsed != 2 || !game.UsedHintAttributes["college"] || !game.UsedHintAttributes["team"] {
+		t.Errorf("hints used %d (revealed %v), want 2: college and team", game.HintsUsed, game.revealedHintAttributes())
+	}
+}
+
+// TestAsyncNeverRevealsOnTimeout checks that -async games have no timer, and that a clock
+// running out in one saves the game instead of revealing the answer, unlike a timed game
+func TestAsyncNeverRevealsOnTimeout(t *testing.T) {
+	useFallbackPlayers(t)
+	config, err := parseTestFlags(t, "-async")
+	if err != nil {
+		t.Fatal(err)
+	}
+	clock := newFakeClock()
+	config.Clock = clock
+	config.SaveFile = filepath.Join(t.TempDir(), "save.json")
+	game := newGame(config, getFallbackPlayers()[0])
+	if game.timeout() != nil {
+		t.Error("an async game has a timer")
+	}
+
+	// Should a time limit still reach the clock, the game is saved, not spoiled
+	outcome := endOnTimeUp(game, inputLines("reveal"))
+	if outcome != OutcomeQuit || game.AnswerShown {
+		t.Errorf("async time-up: outcome %v, answer shown %v; want a quit with the answer hidden", outcome, game.AnswerShown)
+	}
+	if _, err := os.Stat(config.SaveFile); err != nil {
+		t.Errorf("async time-up didn't save the game: %v", err)
+	}
+
+	timed, _ := newTestGame(t, nil)
+	if outcome := endOnTimeUp(timed, inputLines()); outcome != OutcomeTimeUp || !timed.AnswerShown {
+		t.Errorf("timed time-up: outcome %v, answer shown %v; want a time-up that reveals", outcome, timed.AnswerShown)
+	}
+}
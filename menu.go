@@ -0,0 +1,80 @@
+package main
+
+import (
+	"fmt"     // Package for formatted I/O operations
+	"sort"    // Package for sorting slices
+	"strconv" // Package for converting strings to numbers
+	"strings" // Package for string manipulation functions
+)
+
+// menuChoice asks the player to pick one of the options by number or name
+// An empty answer keeps the current value; returns false if input ended
+func menuChoice(input <-chan string, question string, options []string, current string) (string, bool) {
+	fmt.Printf("\n%s\n", question)
+	for i, option := range options {
+		marker := " "
+		if option == current {
+			marker = "*" // Highlight the value kept by pressing Enter
+		}
+		fmt.Printf(" %s %d) %s\n", marker, i+1, option)
+	}
+
+	for {
+		fmt.Printf("Choice [%s]: ", current)
+		answer, ok := <-input
+		if !ok {
+			return current, false
+		}
+		answer = strings.ToLower(strings.TrimSpace(answer))
+		if answer == "" {
+			return current, true
+		}
+		if number, err := strconv.Atoi(answer); err == nil && number >= 1 && number <= len(options) {
+			return options[number-1], true
+		}
+		for _, option := range options {
+			if option == answer {
+				return option, true
+			}
+		}
+		fmt.Println("Please type one of the numbers or names above.")
+	}
+}
+
+// difficultyNames returns the difficulty presets in order from easiest to hardest
+func difficultyNames() []string {
+	names := make([]string, 0, len(difficultyPresets))
+	for name := range difficultyPresets {
+		names = append(names, name)
+	}
+	// More attempts means easier
+	sort.Slice(names, func(i, j int) bool {
+		return difficultyPresets[names[i]].MaxAttempts > difficultyPresets[names[j]].MaxAttempts
+	})
+	return names
+}
+
+// runSettingsMenu lets the player choose difficulty, mode, and theme before the first game
+// Returns false if input ended while the menu was open
+func runSettingsMenu(config *GameConfig, input <-chan string) bool {
+	fmt.Println("⚙️  Game settings - press Enter to keep the current choice (start with -no-menu to skip this)")
+
+	difficulty, ok := menuChoice(input, "Difficulty:", difficultyNames(), config.Difficulty)
+	if !ok {
+		return false
+	}
+	config.applyDifficulty(difficulty) // Names come from difficultyPresets, so this can't fail
+
+	mode, ok := menuChoice(input, "Mode (player: guess whole players, attributes: guess one attribute at a time, team: guess a team):", []string{"player", "attributes", "team"}, config.Mode)
+	if !ok {
+		return false
+	}
+	config.Mode = mode
+
+	theme, ok := menuChoice(input, "Marker theme:", themeNames(), config.Theme)
+	if !ok {
+		return false
+	}
+	config.Theme = theme
+	return true
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"testing" // Package for Go tests
+)
+
+// TestSettingsMenuDrivesGame checks that choices made in the settings menu, by number or by
+// name and after a bad answer, decide the game the engine then plays
+func TestSettingsMenuDrivesGame(t *testing.T) {
+	useFallbackPlayers(t)
+	config := defaultConfig()
+	config.Clock = newFakeClock()
+	config.SaveFile = t.TempDir() + "/save.json"
+	target := getFallbackPlayers()[0]
+	input := inputLines("3", "expert", "Attributes", "", "position: "+target.Position, target.Name)
+
+	if !runSettingsMenu(&config, input) {
+		t.Fatal("the menu ran out of input")
+	}
+	if config.Difficulty != "hard" || config.MaxAttempts != difficultyPresets["hard"].MaxAttempts {
+		t.Errorf("difficulty = %s with %d attempts, want hard", config.Difficulty, config.MaxAttempts)
+	}
+	if config.Mode != "attributes" || config.Theme != "default" {
+		t.Errorf("mode %q and theme %q, want attributes and the default theme kept", config.Mode, config.Theme)
+	}
+
+	game := newGame(config, target)
+	if outcome := playMode(game, input); outcome != OutcomeWon || len(game.Pinned) != 1 {
+		t.Errorf("attribute game after the menu: %v with %d pinned, want a win with 1", outcome, len(game.Pinned))
+	}
+}
+
+// TestSettingsMenuInputEnds checks that the menu reports input ending before every choice was made
+func TestSettingsMenuInputEnds(t *testing.T) {
+	config := defaultConfig()
+	if runSettingsMenu(&config, inputLines("easy")) {
+		t.Error("the menu finished without a mode or theme")
+	}
+	if config.Difficulty != "easy" || config.Mode != defaultConfig().Mode {
+		t.Errorf("difficulty %q and mode %q, want easy and the default mode", config.Difficulty, config.Mode)
+	}
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"math/rand" // Package for generating random numbers
+)
+
+// feedbackKind classifies a guess by how it compares with the earlier guesses
+type feedbackKind int
+
+const (
+	feedbackNone      feedbackKind = iota // Nothing worth commenting on
+	feedbackImproved                      // Best match so far
+	feedbackWorse                         // Weaker than the best guess so far
+	feedbackNoNewInfo                     // Only matched attributes that were already known
+)
+
+// feedbackMessages holds the message pool for each kind of guess; add lines here to extend them
+var feedbackMessages = map[feedbackKind][]string{
+	feedbackImproved: {
+		"🔥 Getting warmer - that's your best guess yet!",
+		"📈 Nice! You're closing in.",
+		"💪 Now we're cooking. Keep that momentum!",
+	},
+	feedbackWorse: {
+		"🧊 Ice cold. That one took you backwards.",
+		"😬 Airball! Your earlier guess was closer.",
+		"🙃 Bold strategy. Not a good one, but bold.",
+	},
+	feedbackNoNewInfo: {
+		"🔁 Nothing new there - you already knew all of that.",
+		"🥱 That guess told you what you already knew.",
+	},
+}
+
+// finishTier is a congratulation used when a game is won within MaxAttempts guesses
+type finishTier struct {
+	MaxAttempts int    // Highest attempt count that earns this tier
+	Message     string // Message shown for the tier
+}
+
+// finishTiers is ordered from best to worst; the first tier that fits is used
+var finishTiers = []finishTier{
+	{MaxAttempts: 1, Message: "🐐 GOAT status - first try!"},
+	{MaxAttempts: 3, Message: "⭐ All-Star performance!"},
+	{MaxAttempts: 5, Message: "🏀 Solid starter minutes."},
+	{MaxAttempts: 1 << 30, Message: "🪑 Off the bench, but you got it done!"},
+}
+
+// classifyGuess compares the latest guess in the history with the earlier ones
+func classifyGuess(history []GuessRecord) feedbackKind {
+	if len(history) == 0 {
+		return feedbackNone
+	}
+	latest := history[len(history)-1].Result
+
+	// Find the best earlier score and every attribute already known to match exactly
+	bestScore := 0
+	known := make(map[string]bool)
+	for _, record := range history[:len(history)-1] {
+		bestScore = max(bestScore, record.Result.matchScore())
+		for attribute, status := range record.Result.Statuses {
+			if status == MatchExact {
+				known[attribute] = true
+			}
+		}
+	}
+
+	score := latest.matchScore()
+	switch {
+	case score > bestScore:
+		return feedbackImproved
+	case score < bestScore:
+		return feedbackWorse
+	}
+
+	// Equal to the best so far - check whether it taught anything new
+	for attribute, status := range latest.Statuses {
+		if status == MatchExact && !known[attribute] {
+			return feedbackNone
+		}
+	}
+	if len(history) > 1 {
+		return feedbackNoNewInfo
+	}
+	return feedbackNone
+}
+
+// guessFeedbackMessage picks a message for the latest guess, or "" when there is nothing to say
+func guessFeedbackMessage(history []GuessRecord, rng *rand.Rand) string {
+	pool := feedbackMessages[classifyGuess(history)]
+	if len(pool) == 0 {
+		return ""
+	}
+	return pool[rng.Intn(len(pool))]
+}
+
+// finishMessage returns the congratulation tier for winning in the given number of attempts
+func finishMessage(attempts int) string {
+	for _, tier := range finishTiers {
+		if attempts <= tier.MaxAttempts {
+			return tier.Message
+		}
+	}
+	return ""
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"math/rand" // Package for a seeded random source
+	"slices"    // Package for searching the message pools
+	"testing"   // Package for Go tests
+)
+
+// statusRecord builds a history entry from attribute statuses alone
+func statusRecord(statuses map[string]MatchStatus) GuessRecord {
+	return GuessRecord{Result: ComparisonResult{Statuses: statuses}}
+}
+
+// TestClassifyGuess checks improving, worsening, and no-new-information guesses
+func TestClassifyGuess(t *testing.T) {
+	weak := statusRecord(map[string]MatchStatus{"team": MatchExact})
+	strong := statusRecord(map[string]MatchStatus{"team": MatchExact, "position": MatchExact, "height": MatchClose})
+	sameTeam := statusRecord(map[string]MatchStatus{"team": MatchExact})
+	newAttribute := statusRecord(map[string]MatchStatus{"country": MatchExact})
+
+	tests := []struct {
+		name    string
+		history []GuessRecord
+		want    feedbackKind
+	}{
+		{"no guesses", nil, feedbackNone},
+		{"first guess with a match", []GuessRecord{weak}, feedbackImproved},
+		{"first guess without one", []GuessRecord{statusRecord(map[string]MatchStatus{"team": MatchMiss})}, feedbackNone},
+		{"improving", []GuessRecord{weak, strong}, feedbackImproved},
+		{"worsening", []GuessRecord{strong, weak}, feedbackWorse},
+		{"only known matches", []GuessRecord{weak, sameTeam}, feedbackNoNewInfo},
+		{"equal score, new match", []GuessRecord{weak, newAttribute}, feedbackNone},
+		{"compared with the best, not the last", []GuessRecord{strong, weak, weak}, feedbackWorse},
+	}
+	for _, test := range tests {
+		if got := classifyGuess(test.history); got != test.want {
+			t.Errorf("%s: classifyGuess() = %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+// TestGuessFeedbackMessage checks that messages come from the pool for the kind of guess
+func TestGuessFeedbackMessage(t *testing.T) {
+	weak := statusRecord(map[string]MatchStatus{"team": MatchExact})
+	strong := statusRecord(map[string]MatchStatus{"team": MatchExact, "position": MatchExact})
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		if message := guessFeedbackMessage([]GuessRecord{weak, strong}, rng); !slices.Contains(feedbackMessages[feedbackImproved], message) {
+			t.Fatalf("an improving guess got %q", message)
+		}
+		if message := guessFeedbackMessage([]GuessRecord{strong, weak}, rng); !slices.Contains(feedbackMessages[feedbackWorse], message) {
+			t.Fatalf("a worsening guess got %q", message)
+		}
+	}
+	if message := guessFeedbackMessage([]GuessRecord{weak, weak}, rng); !slices.Contains(feedbackMessages[feedbackNoNewInfo], message) {
+		t.Errorf("a guess with nothing new got %q", message)
+	}
+	miss := statusRecord(map[string]MatchStatus{"team": MatchMiss})
+	if message := guessFeedbackMessage([]GuessRecord{miss}, rng); message != "" {
+		t.Errorf("a first guess without a match got %q, want no message", message)
+	}
+}
+
+// TestFinishMessage checks the congratulation tier boundaries
+func TestFinishMessage(t *testing.T) {
+	tests := map[int]string{1: finishTiers[0].Message, 2: finishTiers[1].Message, 3: finishTiers[1].Message, 5: finishTiers[2].Message, 6: finishTiers[3].Message}
+	for attempts, want := range tests {
+		if got := finishMessage(attempts); got != want {
+			t.Errorf("finishMessage(%d) = %q, want %q", attempts, got, want)
+		}
+	}
+}
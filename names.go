@@ -0,0 +1,90 @@
+package main
+
+import (
+	"strings" // Package for string manipulation functions
+)
+
+// diacriticReplacer maps accented letters to their plain ASCII equivalents
+var diacriticReplacer = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ä", "a", "ã", "a", "å", "a", "ā", "a",
+	"ç", "c", "ć", "c", "č", "c",
+	"đ", "d",
+	"é", "e", "è", "e", "ê", "e", "ë", "e", "ē", "e", "ė", "e", "ę", "e",
+	"ğ", "g",
+	"í", "i", "ì", "i", "î", "i", "ï", "i", "ī", "i",
+	"ł", "l",
+	"ñ", "n", "ń", "n",
+	"ó", "o", "ò", "o", "ô", "o", "ö", "o", "õ", "o", "ø", "o", "ō", "o",
+	"ř", "r",
+	"š", "s", "ś", "s", "ß", "ss",
+	"ť", "t",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u", "ū", "u",
+	"ý", "y", "ÿ", "y",
+	"ž", "z", "ź", "z", "ż", "z",
+)
+
+// punctuationReplacer drops apostrophes and periods and turns hyphens into spaces, so
+// "De'Aaron" matches "deaaron" and "Gilgeous-Alexander" matches "gilgeous alexander"
+var punctuationReplacer = strings.NewReplacer(
+	"'", "", "’", "", "`", "", ".", "",
+	"-", " ",
+)
+
+// transliterations maps common phonetic spellings of name parts to the spelling the player
+// pool uses, e.g. "yokic" for Jokić, keyed and valued by normalized words
+var transliterations = map[string]string{
+	"yokic": "jokic", "yokich": "jokic", "jokich": "jokic",
+	"donchich": "doncic", "doncich": "doncic", "dontchich": "doncic",
+	"yannis": "giannis", "yiannis": "giannis",
+	"adetokunbo": "antetokounmpo", "antetokunbo": "antetokounmpo", "antetokunmpo": "antetokounmpo",
+	"yovic": "jovic", "yovich": "jovic", "jovich": "jovic",
+	"vucevich": "vucevic", "vuchevich": "vucevic",
+	"bogdanovich": "bogdanovic",
+	"nurkich":     "nurkic",
+	"michich":     "micic", "mitsich": "micic",
+	"shengun":     "sengun",
+	"valanchunas": "valanciunas", "valanchiunas": "valanciunas",
+	"porzinghis": "porzingis",
+}
+
+// transliterate rewrites each word of a normalized name that has a known phonetic spelling,
+// so "nikola yokic" becomes "nikola jokic"
+func transliterate(normalized string) string {
+	words := strings.Fields(normalized)
+	for i, word := range words {
+		if spelling, found := transliterations[word]; found {
+			words[i] = spelling
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// normalizeName lowercases a name, strips diacritics and punctuation, and collapses
+// whitespace so "Nikola  Jokić" and "nikola jokic" compare equal
+func normalizeName(name string) string {
+	lower := punctuationReplacer.Replace(diacriticReplacer.Replace(strings.ToLower(name)))
+	return strings.Join(strings.Fields(lower), " ")
+}
+
+// levenshtein returns the number of single-rune insertions, deletions, and substitutions
+// needed to turn a into b
+func levenshtein(a, b string) int {
+	source, target := []rune(a), []rune(b)
+	previous := make([]int, len(target)+1)
+	current := make([]int, len(target)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(source); i++ {
+		current[0] = i
+		for j := 1; j <= len(target); j++ {
+			cost := 1
+			if source[i-1] == target[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(target)]
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"testing" // Package for Go tests
+)
+
+// TestNormalizeNamePunctuation checks that apostrophes, periods, and hyphens don't stop names
+// from comparing equal
+func TestNormalizeNamePunctuation(t *testing.T) {
+	tests := map[string]string{
+		"De'Aaron Fox":            "deaaron fox",
+		"De’Aaron Fox":            "deaaron fox",
+		"Shai Gilgeous-Alexander": "shai gilgeous alexander",
+		"O.J. Mayo":               "oj mayo",
+		"  Nikola   Jokić ":       "nikola jokic",
+	}
+	for name, want := range tests {
+		if got := normalizeName(name); got != want {
+			t.Errorf("normalizeName(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+// TestFindPunctuatedNames checks that players with apostrophes and hyphens in their names can
+// be found whether or not the guess spells the punctuation out
+func TestFindPunctuatedNames(t *testing.T) {
+	usePlayers(t, []Player{
+		{Name: "De'Aaron Fox", Position: "PG"},
+		{Name: "Shai Gilgeous-Alexander", Position: "SG"},
+	})
+	config := defaultConfig()
+	config.NoFuzzy = true // Only exact and normalized matches
+
+	tests := map[string]string{
+		"De'Aaron Fox":            "De'Aaron Fox",
+		"deaaron fox":             "De'Aaron Fox",
+		"De’Aaron Fox":            "De'Aaron Fox",
+		"shai gilgeous alexander": "Shai Gilgeous-Alexander",
+		"Shai Gilgeous-Alexander": "Shai Gilgeous-Alexander",
+	}
+	for input, want := range tests {
+		player, found := findPlayerByName(input, config)
+		if !found || player.Name != want {
+			t.Errorf("findPlayerByName(%q) = %q, %v; want %q", input, player.Name, found, want)
+		}
+	}
+}
+
+// TestFindTransliteratedNames checks that phonetic spellings of international names find the
+// right player, and only while transliteration is on
+func TestFindTransliteratedNames(t *testing.T) {
+	usePlayers(t, []Player{
+		{Name: "Nikola Jokić", Position: "C"},
+		{Name: "Nikola Jović", Position: "PF"},
+		{Name: "Luka Dončić", Position: "PG"},
+		{Name: "Giannis Antetokounmpo", Position: "PF"},
+	})
+	config := defaultConfig()
+	config.NoFuzzy = true // Typo tolerance alone mustn't explain the match
+
+	tests := map[string]string{
+		"Nikola Yokic":         "Nikola Jokić",
+		"nikola yokich":        "Nikola Jokić",
+		"Nikola Jokich":        "Nikola Jokić",
+		"Nikola Yovich":        "Nikola Jović",
+		"Luka Donchich":        "Luka Dončić",
+		"luka dontchich":       "Luka Dončić",
+		"Yannis Adetokunbo":    "Giannis Antetokounmpo",
+		"Yiannis Antetokunmpo": "Giannis Antetokounmpo",
+	}
+	for input, want := range tests {
+		player, found := findPlayerByName(input, config)
+		if !found || player.Name != want {
+			t.Errorf("findPlayerByName(%q) = %q, %v; want %q", input, player.Name, found, want)
+		}
+	}
+
+	config.NoTransliteration = true
+	if player, found := findPlayerByName("Nikola Yokic", config); found {
+		t.Errorf("with -no-transliteration, findPlayerByName(%q) found %q", "Nikola Yokic", player.Name)
+	}
+}
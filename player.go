@@ -1,74 +1,369 @@
 package main
 
 import (
-	"math/rand" // Package for generating random numbers
-	"strings"   // Package for string manipulation functions
-	"time"      // Package for time-related operations
+	"errors"  // Package for creating error values
+	"fmt"     // Package for formatted I/O operations
+	"strconv" // Package for converting strings to numbers
+	"strings" // Package for string manipulation functions
 )
 
 // Player represents an NBA player with all their relevant attributes for the guessing game
 type Player struct {
-	Name         string // Full name of the player (e.g., "LeBron James")
-	Team         string // Current team or "Retired" for former players
-	Position     string // Playing position (PG, SG, SF, PF, C)
-	Height       string // Player height in feet and inches (e.g., "6'9\"")
-	College      string // College attended or "None" for international/high school players
-	DraftYear    int    // Year the player was drafted into the NBA
-	DraftRound   int    // Round the player was drafted in (1-2, or 0 for undrafted)
-	DraftNumber  int    // Overall pick number in the draft (1-60, or 0 for undrafted)
-	JerseyNumber string // Current jersey number (or "Unknown" if not available)
-	Country      string // Country of origin
-}
-
-// Global variable to store all loaded players
-var players []Player
-
-// initializePlayers loads player data from the NBA API or falls back to hardcoded data
-func initializePlayers() error {
+	ID           int      `json:"id,omitempty"`            // Ball Don't Lie player ID (0 for players without an API record)
+	Name         string   `json:"name"`                    // Full name of the player (e.g., "LeBron James")
+	Team         string   `json:"team"`                    // Current team or "Retired" for former players
+	IconicTeam   string   `json:"iconic_team,omitempty"`   // Team the player is best remembered for ("" if same as or unknown)
+	Position     string   `json:"position"`                // Playing position (PG, SG, SF, PF, C)
+	Height       string   `json:"height"`                  // Player height in feet and inches (e.g., "6'9\"")
+	Weight       int      `json:"weight,omitempty"`        // Player weight in pounds (0 if not available)
+	College      string   `json:"college"`                 // College attended or "None" for international/high school players
+	DraftYear    int      `json:"draft_year"`              // Year the player was drafted into the NBA (unknownDraftYear if not known)
+	DraftRound   int      `json:"draft_round"`             // Round the player was drafted in (1-2, or 0 for undrafted)
+	DraftNumber  int      `json:"draft_number"`            // Overall pick number in the draft (1-60, or 0 for undrafted)
+	JerseyNumber string   `json:"jersey_number"`           // Current jersey number (or "Unknown" if not available)
+	Country      string   `json:"country"`                 // Country of origin
+	Nicknames    []string `json:"nicknames,omitempty"`     // Well-known nicknames that can be typed instead of the name
+	ContractType string   `json:"contract_type,omitempty"` // "two-way" for two-way contracts ("" for standard contracts)
+}
+
+// Draft year bounds and the sentinel used when a player's draft year is not known
+const (
+	firstDraftYear   = 1947 // Year of the first BAA/NBA draft
+	unknownDraftYear = 0    // DraftYear value for players whose draft year is missing or invalid
+)
+
+// contractTwoWay marks a player on a two-way contract, who splits time with a G League affiliate
+const contractTwoWay = "two-way"
+
+// heightInches converts a height like 6'9" into total inches
+// Returns false if the height isn't in feet-and-inches form
+func heightInches(height string) (int, bool) {
+	parts := strings.SplitN(strings.TrimSuffix(height, "\""), "'", 2)
+	if len(parts) != 2 {
+		return 0, false
+	}
+	feet, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return 0, false
+	}
+	inches, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+	if err != nil {
+		return 0, false
+	}
+	return feet*12 + inches, true
+}
+
+// heightQuoteReplacer turns typographic and doubled quote marks into plain feet and inch marks
+var heightQuoteReplacer = strings.NewReplacer("’", "'", "‘", "'", "′", "'", "”", "\"", "“", "\"", "″", "\"", "''", "\"")
+
+// normalizeHeight rewrites equivalent height spellings such as 6-2, 6'2, 6' 2", or 6′2″
+// into the canonical 6'2" form; heights that can't be parsed are returned trimmed
+func normalizeHeight(height string) string {
+	cleaned := strings.TrimSpace(heightQuoteReplacer.Replace(height))
+	if cleaned == "" {
+		return "Unknown"
+	}
+	if strings.Count(cleaned, "-") == 1 && !strings.Contains(cleaned, "'") {
+		cleaned = strings.Replace(cleaned, "-", "'", 1) // API style: feet-inches
+	}
+	if !strings.Contains(cleaned, "\"") {
+		cleaned += "\"" // The inch mark is often left off
+	}
+	if inches, ok := heightInches(cleaned); ok {
+		return fmt.Sprintf("%d'%d\"", inches/12, inches%12)
+	}
+	return strings.TrimSpace(height)
+}
+
+// formatWeight returns the weight for display, or "N/A" when it isn't known
+func formatWeight(weight int) string {
+	if weight == 0 {
+		return "N/A"
+	}
+	return strconv.Itoa(weight)
+}
+
+// draftTier buckets an overall pick number: lottery (#1-14), late first round (#15-30),
+// second round (#31-60), later rounds (#61+, older drafts only), or undrafted (0)
+func draftTier(pick int) string {
+	switch {
+	case pick <= 0:
+		return "undrafted"
+	case pick <= 14:
+		return "lottery"
+	case pick <= 30:
+		return "late first round"
+	case pick <= 60:
+		return "second round"
+	default:
+		return "later round"
+	}
+}
+
+// legendNicknames supplements player data with well-known nicknames, keyed by full name
+var legendNicknames = map[string][]string{
+	"LeBron James":          {"King James", "LBJ"},
+	"Michael Jordan":        {"MJ", "Air Jordan", "His Airness"},
+	"Kobe Bryant":           {"Black Mamba", "Mamba"},
+	"Stephen Curry":         {"Steph", "Chef Curry"},
+	"Kevin Durant":          {"KD", "Slim Reaper"},
+	"Giannis Antetokounmpo": {"Greek Freak", "Giannis"},
+	"Luka Doncic":           {"Luka Magic", "Luka"},
+	"Joel Embiid":           {"The Process"},
+	"Nikola Jokic":          {"Joker", "The Joker"},
+	"Jayson Tatum":          {"JT"},
+	"Shaquille O'Neal":      {"Shaq", "The Diesel"},
+	"Tim Duncan":            {"The Big Fundamental"},
+	"Allen Iverson":         {"The Answer", "AI"},
+	"Karl Malone":           {"The Mailman"},
+	"Magic Johnson":         {"Magic"},
+}
+
+// iconicTeams supplements player data with the team a player is best remembered for, keyed by full name
+// Only players whose iconic team differs from (or isn't captured by) their current team need an entry
+var iconicTeams = map[string]string{
+	"LeBron James":     "Cleveland Cavaliers",
+	"Michael Jordan":   "Chicago Bulls",
+	"Kobe Bryant":      "Los Angeles Lakers",
+	"Kevin Durant":     "Golden State Warriors",
+	"Shaquille O'Neal": "Los Angeles Lakers",
+	"Tim Duncan":       "San Antonio Spurs",
+	"Allen Iverson":    "Philadelphia 76ers",
+	"Karl Malone":      "Utah Jazz",
+	"Magic Johnson":    "Los Angeles Lakers",
+}
+
+// Enricher post-processes the loaded player pool before the game uses it, for example to
+// add nicknames, fix heights, or tag eras
+type Enricher func(pool []Player) []Player
+
+// enrichers run in order on every loaded pool, whether it came from the API, files, or the fallback list
+var enrichers = []Enricher{enrichHeights, enrichNicknames, enrichIconicTeams}
+
+// registerEnricher adds an enricher that runs after the built-in ones
+func registerEnricher(enricher Enricher) {
+	enrichers = append(enrichers, enricher)
+}
+
+// applyEnrichers runs every registered enricher in order over a copy of the pool, since the
+// pool may be the cached API result that other goroutines are reading
+func applyEnrichers(pool []Player) []Player {
+	pool = append([]Player(nil), pool...)
+	for _, enricher := range enrichers {
+		pool = enricher(pool)
+	}
+	return pool
+}
+
+// enrichHeights rewrites every height into the canonical form so equal heights always compare equal
+func enrichHeights(pool []Player) []Player {
+	for i := range pool {
+		pool[i].Height = normalizeHeight(pool[i].Height)
+	}
+	return pool
+}
+
+// enrichNicknames fills in nicknames for legends that have an entry and no nicknames yet
+func enrichNicknames(pool []Player) []Player {
+	for i := range pool {
+		if nicknames, found := legendNicknames[pool[i].Name]; found && len(pool[i].Nicknames) == 0 {
+			pool[i].Nicknames = nicknames
+		}
+	}
+	return pool
+}
+
+// enrichIconicTeams fills in the iconic team for players that have an entry and none yet
+func enrichIconicTeams(pool []Player) []Player {
+	for i := range pool {
+		if team, found := iconicTeams[pool[i].Name]; found && pool[i].IconicTeam == "" {
+			pool[i].IconicTeam = team
+		}
+	}
+	return pool
+}
+
+// freeAgentTeam is the team shown for players who aren't on a team
+const freeAgentTeam = "Free Agent"
+
+// comparedTeam returns the team used for comparisons and hints: the iconic team in
+// iconic team mode when one is known, otherwise the current team
+func comparedTeam(player Player, config GameConfig) string {
+	if config.TeamMode == "iconic" && player.IconicTeam != "" {
+		return player.IconicTeam
+	}
+	return player.Team
+}
+
+// initializePlayers loads player data from custom files or the NBA API, falling back to hardcoded data
+func initializePlayers(config GameConfig) error {
+	// Custom player files replace the API entirely
+	if len(config.PlayersFiles) > 0 || len(config.PlayersCSVFiles) > 0 {
+		filePlayers, err := loadPlayerFiles(config.PlayersFiles, config.PlayersCSVFiles)
+		if err != nil {
+			store.SetPlayers(filterPool(applyEnrichers(getFallbackPlayers()), config))
+			return err
+		}
+		store.SetPlayers(filterPool(applyEnrichers(filePlayers), config))
+		return nil
+	}
+
 	// First attempt to fetch comprehensive player data from NBA API
-	apiPlayers, err := fetchAllPlayers()
+	apiPlayers, err := fetchAllPlayers(config)
 	if err != nil {
 		// If API fails, use the fallback dataset of notable players
-		players = getFallbackPlayers()
+		store.SetPlayers(filterPool(applyEnrichers(getFallbackPlayers()), config))
 		return nil // Return nil since fallback is successful
 	}
 
 	// If API succeeds, use the fetched data
-	players = apiPlayers
+	store.SetPlayers(filterPool(applyEnrichers(apiPlayers), config))
 	return nil
 }
 
-// getRandomPlayer selects and returns a random player from the loaded dataset
-func getRandomPlayer() Player {
+// errNoPlayers is returned when a mystery player is needed but the pool is empty
+var errNoPlayers = errors.New("no players available to choose a mystery player from")
+
+// getRandomPlayer selects and returns a random eligible player from the loaded dataset
+func getRandomPlayer(config GameConfig) (Player, error) {
 	// Ensure players are initialized before selecting random player
+	if len(store.Players()) == 0 {
+		initializePlayers(config) // Initialize if not already done
+	}
+	players := randomTargets(config)
 	if len(players) == 0 {
-		initializePlayers() // Initialize if not already done
+		return Player{}, noTargetsError(store.Players(), config) // Intn(0) would panic
+	}
+
+	// Usually a few draws find a player who wasn't a recent target; only filter the whole
+	// list when the recent players crowd the pool
+	rng := config.rng()
+	recent := recentTargetNames(config.RecentTargets, config.AvoidRecent)
+	for try := 0; try < maxRecentRerolls; try++ {
+		if player := players[rng.Intn(len(players))]; !recent[normalizeName(player.Name)] {
+			return player, nil
+		}
+	}
+	players = avoidRecent(players, config.RecentTargets, config.AvoidRecent)
+
+	// Return a random player from the slice, drawn from the session's random source
+	return players[rng.Intn(len(players))], nil
+}
+
+// getForcedPlayer returns the mystery player named with -target-name, which must be in the pool
+func getForcedPlayer(config GameConfig) (Player, error) {
+	target, found := store.PlayerByName(normalizeName(config.TargetName))
+	if !found {
+		return Player{}, fmt.Errorf("-target-name: %q is not in the player pool (use the player's full name)", config.TargetName)
 	}
+	return target, nil
+}
 
-	// Seed the random number generator with current time for true randomness
-	rand.Seed(time.Now().UnixNano())
+// maxRecentRerolls is how many random draws may land on recent targets before falling back to filtering
+const maxRecentRerolls = 16
 
-	// Return a random player from the slice
-	return players[rand.Intn(len(players))]
+// randomTargets returns the players a random game may pick: eligible, and complete unless none
+// are, computed once per pool and settings rather than on every selection
+func randomTargets(config GameConfig) []Player {
+	return store.Targets(targetSelectionKey(config), func(pool []Player) []Player {
+		return preferComplete(eligibleTargets(pool, config)) // Re-roll away from players with too much missing data
+	})
 }
 
-// findPlayerByName searches for a player by case-insensitive name match
+// getNextRandomPlayer selects a random player different from the previous target when the pool allows it
+func getNextRandomPlayer(previous Player, config GameConfig) (Player, error) {
+	next, err := getRandomPlayer(config)
+	for err == nil && len(randomTargets(config)) > 1 && samePlayer(next, previous) {
+		next, err = getRandomPlayer(config) // Re-roll so back-to-back games never repeat
+	}
+	return next, err
+}
+
+// validateFallbackPlayers checks that the built-in fallback list is usable: non-empty,
+// every record complete, and no name listed twice
+func validateFallbackPlayers(fallback []Player) error {
+	if len(fallback) == 0 {
+		return fmt.Errorf("fallback player list is empty")
+	}
+
+	seen := make(map[string]bool)
+	for i, player := range fallback {
+		if player.Name == "" {
+			return fmt.Errorf("fallback player #%d has no name", i+1)
+		}
+		if seen[player.Name] {
+			return fmt.Errorf("fallback player %s is listed twice", player.Name)
+		}
+		seen[player.Name] = true
+
+		// Every attribute used as a clue must be filled in
+		if player.Team == "" || player.Position == "" || player.College == "" || player.JerseyNumber == "" || player.Country == "" {
+			return fmt.Errorf("fallback player %s is missing a required field", player.Name)
+		}
+		if _, ok := heightInches(player.Height); !ok {
+			return fmt.Errorf("fallback player %s has malformed height %q", player.Name, player.Height)
+		}
+		if !isValidDraftYear(player.DraftYear) {
+			return fmt.Errorf("fallback player %s has invalid draft year %d", player.Name, player.DraftYear)
+		}
+		if (player.DraftRound == 0) != (player.DraftNumber == 0) {
+			return fmt.Errorf("fallback player %s has inconsistent draft round and pick", player.Name)
+		}
+	}
+	return nil
+}
+
+// findPlayerByName searches for a player by case- and accent-insensitive name match
+// Loose partial and typo-tolerant matching is skipped when the config asks for strict matching
 // Returns pointer to player and boolean indicating if found
-func findPlayerByName(name string) (*Player, bool) {
-	// Convert input name to lowercase for case-insensitive comparison
-	lowerName := strings.ToLower(strings.TrimSpace(name))
+func findPlayerByName(name string, config GameConfig) (*Player, bool) {
+	// Normalize the input the same way the name index was built
+	lowerName := normalizeName(name)
+
+	// Exact names are answered by the index without scanning the pool
+	if player, found := store.PlayerByName(lowerName); found {
+		return &player, true
+	}
 
-	// Iterate through all players in the database
-	for _, player := range players {
-		// Check for case-insensitive name match
-		if strings.ToLower(player.Name) == lowerName {
-			return &player, true // Return pointer to player and true if found
+	// -exact-names accepts full names only: no nicknames, partial names, or typos
+	if config.ExactNames {
+		return nil, false
+	}
+
+	// Rewrite phonetic spellings such as "Yokic" and search with the pool's spelling from here on
+	if !config.NoTransliteration {
+		lowerName = transliterate(lowerName)
+		if player, found := store.PlayerByName(lowerName); found {
+			return &player, true
 		}
 	}
 
+	// Next try nicknames, accepting only nicknames that belong to a single player
+	players, names := store.PlayersWithNames()
+	var nicknameMatch *Player
+	nicknameMatches := 0
+	for i := range players {
+		for _, nickname := range players[i].Nicknames {
+			if normalizeName(nickname) == lowerName {
+				nicknameMatch = &players[i]
+				nicknameMatches++
+				break
+			}
+		}
+	}
+	if nicknameMatches == 1 {
+		player := *nicknameMatch // Return a copy so callers can't modify the shared pool
+		return &player, true
+	}
+
+	// Strict matching stops at exact names and nicknames
+	if config.NoFuzzy {
+		return nil, false
+	}
+
 	// If exact match not found, try partial matching for common variations
-	for _, player := range players {
-		playerLower := strings.ToLower(player.Name)
+	for i, player := range players {
+		playerLower := names[i] // Already normalized when the pool was loaded
 
 		// Check if the input matches any part of the player's name (for nicknames or partial names)
 		if strings.Contains(playerLower, lowerName) && len(lowerName) >= 3 {
@@ -82,13 +377,57 @@ func findPlayerByName(name string) (*Player, bool) {
 		}
 	}
 
+	// Finally forgive small typos: take the closest name within the allowed edit distance
+	if config.FuzzyDistance > 0 && len(lowerName) > config.FuzzyDistance {
+		bestIndex, bestDistance := -1, config.FuzzyDistance+1
+		for i := range players {
+			if distance := levenshtein(lowerName, names[i]); distance < bestDistance {
+				bestIndex, bestDistance = i, distance
+			}
+		}
+		if bestIndex >= 0 {
+			player := players[bestIndex]
+			return &player, true
+		}
+	}
+
 	// Return nil pointer and false if player not found
 	return nil, false
 }
 
+// samePlayer reports whether two records describe the same player, using the API ID
+// when available and the name otherwise (fallback players have no API ID)
+func samePlayer(a, b Player) bool {
+	if a.ID != 0 || b.ID != 0 {
+		return a.ID == b.ID && a.Name == b.Name // Both ID and name must agree
+	}
+	return a.Name == b.Name // Name is the only identity available
+}
+
+// playerLess orders two players by name, then by API ID: the tie-break every ranking uses so
+// players with equal scores always come out in the same order
+func playerLess(a, b Player) bool {
+	if a.Name != b.Name {
+		return a.Name < b.Name
+	}
+	return a.ID < b.ID
+}
+
+// findPlayerByID searches for a player by API ID, falling back to an exact name match
+// when the ID is 0
+func findPlayerByID(id int, name string) (*Player, bool) {
+	for _, player := range store.Players() {
+		if samePlayer(player, Player{ID: id, Name: name}) {
+			return &player, true
+		}
+	}
+	return nil, false
+}
+
 // getAllPlayerNames returns a slice containing all player names in the database
 func getAllPlayerNames() []string {
 	// Create slice with capacity equal to number of players
+	players := store.Players()
 	names := make([]string, len(players))
 
 	// Extract name from each player and add to names slice
@@ -0,0 +1,483 @@
+package main
+
+import (
+	"fmt"           // Package for formatted I/O operations
+	"math/rand"     // Package for a seeded random source
+	"path/filepath" // Package for building file paths
+	"slices"        // Package for searching name lists
+	"sort"          // Package for sorting slices
+	"strings"       // Package for string manipulation functions
+	"testing"       // Package for Go tests
+)
+
+// TestFallbackPlayersAreValid fails the build if the built-in list is ever empty or incomplete
+func TestFallbackPlayersAreValid(t *testing.T) {
+	if err := validateFallbackPlayers(getFallbackPlayers()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestValidateFallbackPlayersRejects checks each problem the startup check guards against
+func TestValidateFallbackPlayersRejects(t *testing.T) {
+	valid := getFallbackPlayers()[0]
+	tests := []struct {
+		name    string
+		players []Player
+		want    string
+	}{
+		{"empty", nil, "empty"},
+		{"no name", []Player{func() Player { p := valid; p.Name = ""; return p }()}, "has no name"},
+		{"listed twice", []Player{valid, valid}, "listed twice"},
+		{"missing field", []Player{func() Player { p := valid; p.College = ""; return p }()}, "missing a required field"},
+		{"bad height", []Player{func() Player { p := valid; p.Height = "tall"; return p }()}, "malformed height"},
+		{"draft mismatch", []Player{func() Player { p := valid; p.DraftNumber = 0; return p }()}, "inconsistent draft"},
+	}
+	for _, test := range tests {
+		err := validateFallbackPlayers(test.players)
+		if err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("%s: got %v, want an error containing %q", test.name, err, test.want)
+		}
+	}
+}
+
+// usePlayers loads the players into the store for one test or benchmark
+func usePlayers(tb testing.TB, players []Player) {
+	tb.Helper()
+	previous := store.Players()
+	store.SetPlayers(players)
+	tb.Cleanup(func() { store.SetPlayers(previous) })
+}
+
+// useFallbackPlayers loads the built-in players into the store for one test
+func useFallbackPlayers(t *testing.T) []Player {
+	t.Helper()
+	players := getFallbackPlayers()
+	usePlayers(t, players)
+	return players
+}
+
+// benchmarkPool returns a pool of n generated players, with a few repeated names to exercise
+// the first-player-wins rule of the name index
+func benchmarkPool(n int) []Player {
+	pool := make([]Player, n)
+	for i := range pool {
+		pool[i] = Player{ID: i + 1, Name: fmt.Sprintf("Test Player%04d", i), Position: "G"}
+		if i%100 == 99 {
+			pool[i].Name = pool[i-1].Name // Same name, different player
+		}
+	}
+	return pool
+}
+
+// scanPlayerByName is the linear scan exact lookups used before the name index, kept as the
+// reference the index must agree with
+func scanPlayerByName(pool []Player, name string) (Player, bool) {
+	for _, player := range pool {
+		if normalizeName(player.Name) == normalizeName(name) {
+			return player, true
+		}
+	}
+	return Player{}, false
+}
+
+// TestNameIndexMatchesScan checks that the index finds exactly the player the scan finds,
+// the first one for repeated names, however the name is typed
+func TestNameIndexMatchesScan(t *testing.T) {
+	pool := append(benchmarkPool(1000), getFallbackPlayers()...)
+	usePlayers(t, pool)
+
+	for i, player := range pool {
+		if i < 1000 && i%50 != 0 && i%100 < 98 {
+			continue // Sample the generated players, keeping every repeated name
+		}
+		for _, typed := range []string{player.Name, strings.ToUpper(player.Name), "  " + player.Name + " "} {
+			want, _ := scanPlayerByName(pool, typed)
+			got, found := findPlayerByName(typed, defaultConfig())
+			if !found || !samePlayer(*got, want) {
+				t.Fatalf("findPlayerByName(%q) = %v, %v; the scan finds %s (ID %d)", typed, got, found, want.Name, want.ID)
+			}
+		}
+	}
+}
+
+// TestFindPlayerByNameStages checks the result of each lookup stage, in order
+func TestFindPlayerByNameStages(t *testing.T) {
+	usePlayers(t, applyEnrichers(getFallbackPlayers()))
+
+	tests := []struct {
+		stage  string
+		input  string
+		change func(*GameConfig)
+		want   string // "" when no player is found
+	}{
+		{"exact", "LeBron James", nil, "LeBron James"},
+		{"case and accents", "NIKOLA JOKIĆ", nil, "Nikola Jokic"},
+		{"transliteration", "Nikola Yokic", nil, "Nikola Jokic"},
+		{"nickname", "Greek Freak", nil, "Giannis Antetokounmpo"},
+		{"partial", "embi", nil, "Joel Embiid"},
+		{"reverse partial", "Jayson Tatum Jr", nil, "Jayson Tatum"},
+		{"typo", "Stephen Cury", nil, "Stephen Curry"},
+		{"unknown", "Zzzz Qqqq", nil, ""},
+		{"exact names only", "Greek Freak", func(c *GameConfig) { c.ExactNames = true }, ""},
+		{"exact names still exact", "lebron james", func(c *GameConfig) { c.ExactNames = true }, "LeBron James"},
+		{"no fuzzy keeps nicknames", "Greek Freak", func(c *GameConfig) { c.NoFuzzy = true }, "Giannis Antetokounmpo"},
+		{"no fuzzy", "Stephen Cury", func(c *GameConfig) { c.NoFuzzy = true }, ""},
+		{"no typo forgiveness", "Stephen Cury", func(c *GameConfig) { c.FuzzyDistance = 0 }, ""},
+	}
+	for _, test := range tests {
+		config := defaultConfig()
+		if test.change != nil {
+			test.change(&config)
+		}
+		got := ""
+		if player, found := findPlayerByName(test.input, config); found {
+			got = player.Name
+		}
+		if got != test.want {
+			t.Errorf("%s: findPlayerByName(%q) = %q, want %q", test.stage, test.input, got, test.want)
+		}
+	}
+}
+
+// BenchmarkFindPlayerByName compares the old linear scan with the name index on a 1000-player
+// pool, looking up the last player, where the scan does the most work
+func BenchmarkFindPlayerByName(b *testing.B) {
+	pool := benchmarkPool(1000)
+	name := pool[len(pool)-1].Name
+	usePlayers(b, pool)
+	config := defaultConfig()
+
+	b.Run("scan", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			scanPlayerByName(pool, name)
+		}
+	})
+	b.Run("index", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			findPlayerByName(name, config)
+		}
+	})
+}
+
+// TestRandomTargetsFollowConfig checks that changing a setting in the selection key changes the
+// targets, even though the pool didn't change
+func TestRandomTargetsFollowConfig(t *testing.T) {
+	usePlayers(t, getFallbackPlayers())
+	config := defaultConfig()
+	all := len(randomTargets(config))
+
+	jordan, _ := store.PlayerByName("michael jordan")
+	config.DraftClass = jordan.DraftYear
+	for _, target := range randomTargets(config) {
+		if target.DraftYear != jordan.DraftYear {
+			t.Fatalf("-draft-class=%d still offers %s from %d", jordan.DraftYear, target.Name, target.DraftYear)
+		}
+	}
+
+	config.DraftClass = 0
+	config.ExcludedTargets = map[string]bool{"michael jordan": true}
+	if got := len(randomTargets(config)); got != all-1 {
+		t.Errorf("excluding a player left %d targets, want %d", got, all-1)
+	}
+}
+
+// BenchmarkRandomTarget compares a random pick that filters the pool every time with one that
+// uses the cached targets, on a 1000-player pool
+func BenchmarkRandomTarget(b *testing.B) {
+	pool := benchmarkPool(1000)
+	usePlayers(b, pool)
+	config := defaultConfig()
+
+	b.Run("uncached", func(b *testing.B) {
+		rng := config.rng()
+		for i := 0; i < b.N; i++ {
+			targets := preferComplete(eligibleTargets(store.Players(), config))
+			_ = targets[rng.Intn(len(targets))]
+		}
+	})
+	b.Run("cached", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := getRandomPlayer(config); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// TestNicknames checks nickname lookups: any case, enriched legends, and nicknames two players share
+func TestNicknames(t *testing.T) {
+	pool := applyEnrichers(getFallbackPlayers())
+	twin := Player{Name: "Other Mamba", Position: "SG", Nicknames: []string{"Mamba"}}
+	usePlayers(t, append(pool, twin))
+	config := defaultConfig()
+	config.NoFuzzy = true // Only exact names and nicknames
+
+	tests := map[string]string{
+		"Black Mamba": "Kobe Bryant",
+		"black mamba": "Kobe Bryant",
+		"KING JAMES":  "LeBron James",
+		"The Joker":   "Nikola Jokic",
+		"Mamba":       "", // Shared by two players: ambiguous, so not a match
+	}
+	for input, want := range tests {
+		got := ""
+		if player, found := findPlayerByName(input, config); found {
+			got = player.Name
+		}
+		if got != want {
+			t.Errorf("findPlayerByName(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+// TestEnrichNicknames checks that legends get their nicknames without overwriting ones already loaded
+func TestEnrichNicknames(t *testing.T) {
+	pool := []Player{
+		{Name: "Kobe Bryant"},
+		{Name: "Michael Jordan", Nicknames: []string{"Mike"}},
+		{Name: "Test Player"},
+	}
+	enriched := enrichNicknames(pool)
+	if len(enriched[0].Nicknames) == 0 {
+		t.Error("Kobe Bryant got no nicknames")
+	}
+	if len(enriched[1].Nicknames) != 1 || enriched[1].Nicknames[0] != "Mike" {
+		t.Errorf("loaded nicknames were replaced: %v", enriched[1].Nicknames)
+	}
+	if len(enriched[2].Nicknames) != 0 {
+		t.Errorf("a player without an entry got nicknames: %v", enriched[2].Nicknames)
+	}
+}
+
+// TestDraftTier checks both sides of every tier boundary, undrafted included
+func TestDraftTier(t *testing.T) {
+	tests := []struct {
+		pick int
+		want string
+	}{
+		{0, "undrafted"},
+		{-1, "undrafted"},
+		{1, "lottery"},
+		{14, "lottery"},
+		{15, "late first round"},
+		{30, "late first round"},
+		{31, "second round"},
+		{60, "second round"},
+		{61, "later round"},
+	}
+	for _, test := range tests {
+		if got := draftTier(test.pick); got != test.want {
+			t.Errorf("draftTier(%d) = %q, want %q", test.pick, got, test.want)
+		}
+	}
+}
+
+// TestRegisteredEnricherReachesPool checks that an enricher registered after the built-in
+// ones changes the pool the game plays with, after the built-in enrichers have run
+func TestRegisteredEnricherReachesPool(t *testing.T) {
+	previous := enrichers
+	t.Cleanup(func() { enrichers = previous })
+	usePlayers(t, store.Players()) // Restore the pool afterwards
+	registerEnricher(func(pool []Player) []Player {
+		for i := range pool {
+			if pool[i].Height == "6'6\"" {
+				pool[i].College = "Enriched"
+			}
+		}
+		return append(pool, Player{Name: "Added Player", Position: "C"})
+	})
+
+	file := writeTestFile(t, "players.json", `[{"name": "Test Player", "position": "SG", "height": "6-6", "college": "Duke"}]`)
+	config := defaultConfig()
+	config.PlayersFiles = []string{file}
+	if err := initializePlayers(config); err != nil {
+		t.Fatal(err)
+	}
+	player, found := store.PlayerByName("test player")
+	if !found || player.College != "Enriched" {
+		t.Errorf("Test Player = %+v, want the college set by the enricher after heights were normalized", player)
+	}
+	if _, found := store.PlayerByName("added player"); !found {
+		t.Error("the player the enricher added isn't in the pool")
+	}
+}
+
+// TestFuzzyDistance checks strict and fuzzy matching on the same inputs: each typo is accepted
+// only up to the configured edit distance, and -no-fuzzy accepts none
+func TestFuzzyDistance(t *testing.T) {
+	usePlayers(t, applyEnrichers(getFallbackPlayers()))
+	inputs := []string{"LeBron James", "Stephen Cury", "Lebron Jmaes", "Stehpen Cury"} // 0, 1, 2, and 3 typos
+	tests := []struct {
+		name   string
+		change func(*GameConfig)
+		want   []string // The player each input finds, "" for none
+	}{
+		{"no fuzzy", func(c *GameConfig) { c.NoFuzzy = true }, []string{"LeBron James", "", "", ""}},
+		{"distance 0", func(c *GameConfig) { c.FuzzyDistance = 0 }, []string{"LeBron James", "", "", ""}},
+		{"distance 1", func(c *GameConfig) { c.FuzzyDistance = 1 }, []string{"LeBron James", "Stephen Curry", "", ""}},
+		{"distance 2", nil, []string{"LeBron James", "Stephen Curry", "LeBron James", ""}},
+		{"distance 3", func(c *GameConfig) { c.FuzzyDistance = 3 }, []string{"LeBron James", "Stephen Curry", "LeBron James", "Stephen Curry"}},
+	}
+	for _, test := range tests {
+		config := defaultConfig()
+		if test.change != nil {
+			test.change(&config)
+		}
+		for i, input := range inputs {
+			got := ""
+			if player, found := findPlayerByName(input, config); found {
+				got = player.Name
+			}
+			if got != test.want[i] {
+				t.Errorf("%s: findPlayerByName(%q) = %q, want %q", test.name, input, got, test.want[i])
+			}
+		}
+	}
+}
+
+// TestNormalizeHeight checks that equivalent height spellings all normalize to one form
+func TestNormalizeHeight(t *testing.T) {
+	tests := map[string]string{
+		`6'2"`:   `6'2"`,
+		"6-2":    `6'2"`,
+		"6'2":    `6'2"`,
+		`6' 2"`:  `6'2"`,
+		" 6-2 ":  `6'2"`,
+		"6′2″":   `6'2"`,
+		"6’2”":   `6'2"`,
+		"6'2''":  `6'2"`,
+		"5-14":   `6'2"`, // Inches past a foot carry over
+		`7'0"`:   `7'0"`,
+		"7-0":    `7'0"`,
+		"":       "Unknown",
+		"   ":    "Unknown",
+		"tall":   "tall",
+		"6 ft 2": "6 ft 2",
+	}
+	for height, want := range tests {
+		if got := normalizeHeight(height); got != want {
+			t.Errorf("normalizeHeight(%q) = %q, want %q", height, got, want)
+		}
+	}
+}
+
+// TestExactNamesRejectsLooseMatches checks that -exact-names turns away every loose match the
+// default settings accept, while full names still match in any case or spelling of accents
+func TestExactNamesRejectsLooseMatches(t *testing.T) {
+	usePlayers(t, applyEnrichers(getFallbackPlayers()))
+	loose := defaultConfig()
+	strict, err := parseTestFlags(t, "-exact-names")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, input := range []string{"embi", "Jayson Tatum Jr", "Stephen Cury", "Greek Freak", "Nikola Yokic", "lebron"} {
+		if _, found := findPlayerByName(input, loose); !found {
+			t.Errorf("default settings don't accept %q, so it can't test -exact-names", input)
+		}
+		if player, found := findPlayerByName(input, strict); found {
+			t.Errorf("-exact-names accepted %q as %s", input, player.Name)
+		}
+	}
+	for _, input := range []string{"Stephen Curry", "STEPHEN CURRY", "Nikola Jokić", "nikola jokic"} {
+		if _, found := findPlayerByName(input, strict); !found {
+			t.Errorf("-exact-names rejected the full name %q", input)
+		}
+	}
+}
+
+// TestRecentTargetsNotRepeated checks that random games never pick any of the last
+// -avoid-recent mystery players, including when the window covers most of the pool
+func TestRecentTargetsNotRepeated(t *testing.T) {
+	players := useFallbackPlayers(t)
+	for _, window := range []int{3, len(players) - 1} {
+		config := defaultConfig()
+		config.Rand = rand.New(rand.NewSource(int64(window)))
+		config.StatsFile = filepath.Join(t.TempDir(), "stats.json")
+		config.AvoidRecent = window
+
+		for game := 0; game < 3*len(players); game++ {
+			target, err := getRandomPlayer(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			recent := config.RecentTargets[max(len(config.RecentTargets)-window, 0):]
+			if slices.Contains(recent, target.Name) {
+				t.Fatalf("window %d, game %d: picked %s again within %v", window, game+1, target.Name, recent)
+			}
+			if err := rememberTarget(&config, target); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		stats, err := loadStats(config.StatsFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(stats.Recent, config.RecentTargets) {
+			t.Errorf("window %d: saved recent targets %v, want %v", window, stats.Recent, config.RecentTargets)
+		}
+	}
+}
+
+// TestForcedTarget checks that -target-name makes the named player the mystery player whatever
+// the random seed, and that unknown names and seeded modes are refused
+func TestForcedTarget(t *testing.T) {
+	useFallbackPlayers(t)
+	for _, name := range []string{"Stephen Curry", "stephen  CURRY"} {
+		config, err := parseTestFlags(t, "-target-name", name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for seed := int64(1); seed <= 5; seed++ {
+			config.Rand = rand.New(rand.NewSource(seed))
+			target, err := getForcedPlayer(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if target.Name != "Stephen Curry" {
+				t.Errorf("-target-name %q with seed %d: the mystery player is %s", name, seed, target.Name)
+			}
+		}
+	}
+
+	config, err := parseTestFlags(t, "-target-name", "Steph Curr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if target, err := getForcedPlayer(config); err == nil {
+		t.Errorf("a name that isn't in the pool forced %s", target.Name)
+	}
+	if _, err := parseTestFlags(t, "-target-name", "Stephen Curry", "-daily"); err == nil {
+		t.Error("-target-name -daily was accepted")
+	}
+}
+
+// TestTieBreakIsStable checks that players who tie sort identically whatever order they come
+// in, by name and then by API ID, and that the suggestion among tied players never changes
+func TestTieBreakIsStable(t *testing.T) {
+	twin := func(name string, id int) Player {
+		p, _ := comparePlayers(func(p *Player) { p.Name, p.ID = name, id }, nil)
+		return p
+	}
+	tied := []Player{twin("Test Baker", 7), twin("Test Adams", 9), twin("Test Adams", 3), twin("Test Chen", 1)}
+	want := []string{"Test Adams #3", "Test Adams #9", "Test Baker #7", "Test Chen #1"}
+
+	rng := rand.New(rand.NewSource(1))
+	for run := 0; run < 20; run++ {
+		players := slices.Clone(tied)
+		rng.Shuffle(len(players), func(i, j int) { players[i], players[j] = players[j], players[i] })
+
+		suggestion, _ := suggestGuess(players, defaultConfig())
+		if suggestion.Name != "Test Adams" || suggestion.ID != 3 {
+			t.Errorf("run %d: suggested %s #%d, want Test Adams #3", run+1, suggestion.Name, suggestion.ID)
+		}
+		sort.Slice(players, func(i, j int) bool { return playerLess(players[i], players[j]) })
+		var got []string
+		for _, player := range players {
+			got = append(got, fmt.Sprintf("%s #%d", player.Name, player.ID))
+		}
+		if !slices.Equal(got, want) {
+			t.Errorf("run %d: sorted %v, want %v", run+1, got, want)
+		}
+	}
+}
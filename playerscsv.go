@@ -0,0 +1,137 @@
+package main
+
+import (
+	"encoding/csv" // Package for reading CSV files with proper quoting
+	"errors"       // Package for inspecting CSV parse errors
+	"fmt"          // Package for formatted I/O operations
+	"io"           // Package for detecting the end of the file
+	"os"           // Package for file operations
+	"strconv"      // Package for converting strings to numbers
+	"strings"      // Package for string manipulation functions
+)
+
+// playersCSVColumns lists every column a players CSV may have; only name is required
+// Nicknames are separated by semicolons within their cell
+var playersCSVColumns = []string{
+	"name", "team", "position", "height", "weight", "college", "draft_year", "draft_round",
+	"draft_number", "jersey_number", "country", "iconic_team", "nicknames", "contract_type", "id",
+}
+
+// csvNumber parses a numeric cell; empty cells are 0, like a missing JSON field
+func csvNumber(value, column string) (int, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, nil
+	}
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("%s %q is not a whole number", column, value)
+	}
+	return number, nil
+}
+
+// playerFromCSVRow builds a player from one data row, using the header to find each column
+func playerFromCSVRow(row []string, columns map[string]int) (Player, error) {
+	cell := func(column string) string {
+		if i, found := columns[column]; found && i < len(row) {
+			return strings.TrimSpace(row[i])
+		}
+		return ""
+	}
+
+	player := Player{
+		Name:         cell("name"),
+		Team:         cell("team"),
+		Position:     cell("position"),
+		Height:       cell("height"), // Normalized to 6'9" by the height enricher like every pool
+		College:      cell("college"),
+		JerseyNumber: cell("jersey_number"),
+		Country:      cell("country"),
+		IconicTeam:   cell("iconic_team"),
+		ContractType: cell("contract_type"),
+	}
+	for _, nickname := range strings.Split(cell("nicknames"), ";") {
+		if nickname = strings.TrimSpace(nickname); nickname != "" {
+			player.Nicknames = append(player.Nicknames, nickname)
+		}
+	}
+
+	numbers := []struct {
+		column string
+		dst    *int
+	}{
+		{"weight", &player.Weight},
+		{"draft_year", &player.DraftYear},
+		{"draft_round", &player.DraftRound},
+		{"draft_number", &player.DraftNumber},
+		{"id", &player.ID},
+	}
+	for _, number := range numbers {
+		value, err := csvNumber(cell(number.column), number.column)
+		if err != nil {
+			return Player{}, err
+		}
+		*number.dst = value
+	}
+
+	// The same checks and defaults as JSON players files
+	if err := validatePlayer(&player); err != nil {
+		return Player{}, err
+	}
+	return player, nil
+}
+
+// loadPlayersCSV reads players from a CSV file whose first row names the columns
+// Errors name the line of the bad row
+func loadPlayersCSV(path string) ([]Player, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read players CSV: %v", err)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1 // Short rows are reported below with their line number
+	reader.LazyQuotes = true    // Hand-written heights like 6'9" have a bare quote
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to read the header row: %v", path, err)
+	}
+
+	known := make(map[string]bool)
+	for _, column := range playersCSVColumns {
+		known[column] = true
+	}
+	columns := make(map[string]int)
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\uFEFF"))) // Spreadsheets may add a byte order mark
+		if !known[name] {
+			return nil, fmt.Errorf("%s line 1: unknown column %q (columns: %s)", path, name, strings.Join(playersCSVColumns, ", "))
+		}
+		columns[name] = i
+	}
+	if _, found := columns["name"]; !found {
+		return nil, fmt.Errorf("%s line 1: the header has no name column", path)
+	}
+
+	var players []Player
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		line, _ := reader.FieldPos(0)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", path, err) // csv.ParseError already names the line
+		}
+		if len(row) != len(header) {
+			return nil, fmt.Errorf("%s line %d: expected %d columns, got %d", path, line, len(header), len(row))
+		}
+		player, err := playerFromCSVRow(row, columns)
+		if err != nil {
+			return nil, fmt.Errorf("%s line %d: %v", path, line, err)
+		}
+		players = append(players, player)
+	}
+	return players, nil
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"strings" // Package for string manipulation functions
+	"testing" // Package for Go tests
+)
+
+// TestLoadPlayersCSV checks header mapping, missing columns, and malformed rows
+func TestLoadPlayersCSV(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []Player // Checked fields: Name, Team, Position, Weight, DraftYear, Nicknames, ID
+		wantErr string
+	}{
+		{
+			name:    "columns in any order",
+			content: "team,Name,weight,draft_year,nicknames,id\nChicago Bulls,Michael Jordan,216,1984,MJ; Air Jordan,7\n",
+			want:    []Player{{Name: "Michael Jordan", Team: "Chicago Bulls", Position: "Unknown", Weight: 216, DraftYear: 1984, Nicknames: []string{"MJ", "Air Jordan"}, ID: 7}},
+		},
+		{
+			name:    "byte order mark and only a name",
+			content: "\uFEFFname\nLarry Bird\n",
+			want:    []Player{{Name: "Larry Bird", Team: freeAgentTeam, Position: "Unknown"}},
+		},
+		{
+			name:    "quoted cells and a bare quote",
+			content: "name,height,college\n\"O'Neal, Shaquille\",7'1\",LSU\n",
+			want:    []Player{{Name: "O'Neal, Shaquille", Team: freeAgentTeam, Position: "Unknown"}},
+		},
+		{"header only", "name,team\n", nil, ""},
+		{"empty file", "", nil, "failed to read the header row"},
+		{"no name column", "team,position\nBoston Celtics,SF\n", nil, "line 1: the header has no name column"},
+		{"unknown column", "name,shoe_size\nLarry Bird,13\n", nil, `line 1: unknown column "shoe_size"`},
+		{"short row", "name,team\nLarry Bird,Boston Celtics\nMagic Johnson\n", nil, "line 3: expected 2 columns, got 1"},
+		{"long row", "name\nLarry Bird,Boston Celtics\n", nil, "line 2: expected 1 columns, got 2"},
+		{"bad number", "name,weight\nLarry Bird,heavy\n", nil, `line 2: weight "heavy" is not a whole number`},
+		{"missing name", "name,team\n,Boston Celtics\n", nil, "line 2: missing name"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := writeTestFile(t, "players.csv", test.content)
+			players, err := loadPlayersCSV(path)
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("loadPlayersCSV() = %v, want an error containing %q", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(players) != len(test.want) {
+				t.Fatalf("loaded %d players, want %d", len(players), len(test.want))
+			}
+			for i, want := range test.want {
+				got := players[i]
+				if got.Name != want.Name || got.Team != want.Team || got.Position != want.Position || got.Weight != want.Weight ||
+					got.DraftYear != want.DraftYear || got.ID != want.ID || strings.Join(got.Nicknames, "|") != strings.Join(want.Nicknames, "|") {
+					t.Errorf("player %d = %+v, want %+v", i+1, got, want)
+				}
+			}
+		})
+	}
+}
+
+// TestLoadPlayerFilesWithCSV checks that CSV files merge after the JSON files, overriding them
+func TestLoadPlayerFilesWithCSV(t *testing.T) {
+	json := writeTestFile(t, "players.json", `[{"name": "Larry Bird", "team": "Retired"}]`)
+	csv := writeTestFile(t, "players.csv", "name,team\nLarry Bird,Boston Celtics\nMagic Johnson,Los Angeles Lakers\n")
+	merged, err := loadPlayerFiles([]string{json}, []string{csv})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(merged) != 2 || merged[0].Team != "Boston Celtics" || merged[1].Name != "Magic Johnson" {
+		t.Errorf("merged %+v, want Larry Bird (Boston Celtics) then Magic Johnson", merged)
+	}
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"encoding/json" // Package for JSON encoding and decoding
+	"fmt"           // Package for formatted I/O operations
+	"os"            // Package for file operations
+	"strings"       // Package for string manipulation functions
+)
+
+// stringList collects every value of a flag that may be repeated
+type stringList []string
+
+// String returns the collected values for flag usage output
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+// Set appends one occurrence of the flag
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
+
+// loadPlayersFile reads a JSON array of players from a custom player file
+func loadPlayersFile(path string) ([]Player, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read players file: %v", err)
+	}
+
+	var filePlayers []Player
+	if err := json.Unmarshal(data, &filePlayers); err != nil {
+		return nil, fmt.Errorf("failed to parse players file %s: %v", path, err)
+	}
+
+	// Validate each record the same way API data is cleaned up
+	for i := range filePlayers {
+		if err := validatePlayer(&filePlayers[i]); err != nil {
+			return nil, fmt.Errorf("%s: player #%d: %v", path, i+1, err)
+		}
+	}
+	return filePlayers, nil
+}
+
+// validatePlayer rejects records without a name and normalizes the remaining fields
+func validatePlayer(player *Player) error {
+	player.Name = strings.TrimSpace(player.Name)
+	if player.Name == "" {
+		return fmt.Errorf("missing name")
+	}
+
+	// Apply the same defaults the API loader uses for missing values
+	player.Position = getPosition(player.Position)
+	if player.Team == "" {
+		player.Team = freeAgentTeam
+	}
+	if player.Height == "" {
+		player.Height = "Unknown"
+	}
+	player.College = getCollege(player.College)
+	player.JerseyNumber = getJerseyNumber(player.JerseyNumber)
+	player.Country = getCountry(player.Country)
+
+	// Only two-way contracts are labeled; anything else is a standard contract
+	player.ContractType = strings.ToLower(strings.TrimSpace(player.ContractType))
+	if player.ContractType != "" && player.ContractType != contractTwoWay {
+		fmt.Printf("Warning: %s has unknown contract type %q - treated as a standard contract\n", player.Name, player.ContractType)
+		player.ContractType = ""
+	}
+
+	// Out-of-range draft years become unknown rather than misleading values
+	if player.DraftYear != unknownDraftYear && !isValidDraftYear(player.DraftYear) {
+		fmt.Printf("Warning: %s has invalid draft year %d - marked as unknown\n", player.Name, player.DraftYear)
+		player.DraftYear = unknownDraftYear
+	}
+	return nil
+}
+
+// playerKey identifies a player for de-duplication: by API ID when present, otherwise by name
+func playerKey(player Player) string {
+	if player.ID != 0 {
+		return fmt.Sprintf("id:%d", player.ID)
+	}
+	return "name:" + strings.ToLower(player.Name)
+}
+
+// loadPlayerFiles loads every JSON players file and then every players CSV in order and merges
+// them; a player loaded again replaces the earlier copy in place, so later files override
+// earlier ones and the pool keeps the order players first appeared in
+func loadPlayerFiles(jsonPaths, csvPaths []string) ([]Player, error) {
+	var merged []Player
+	seenIn := make(map[string]string) // Player key -> file the current copy was loaded from
+	index := make(map[string]int)     // Player key -> position in merged
+
+	paths := append(append([]string{}, jsonPaths...), csvPaths...)
+	for i, path := range paths {
+		load := loadPlayersFile
+		if i >= len(jsonPaths) {
+			load = loadPlayersCSV
+		}
+		filePlayers, err := load(path)
+		if err != nil {
+			return nil, err
+		}
+
+		added, replaced := 0, 0
+		for _, player := range filePlayers {
+			key := playerKey(player)
+			if earlierFile, seen := seenIn[key]; seen {
+				fmt.Printf("Replacing %s from %s with the copy in %s\n", player.Name, earlierFile, path)
+				merged[index[key]] = player
+				seenIn[key] = path
+				replaced++
+				continue
+			}
+			seenIn[key] = path
+			index[key] = len(merged)
+			merged = append(merged, player)
+			added++
+		}
+		if replaced > 0 {
+			fmt.Printf("Loaded %d players from %s (%d replacing earlier copies)\n", added, path, replaced)
+		} else {
+			fmt.Printf("Loaded %d players from %s\n", added, path)
+		}
+	}
+
+	if len(merged) == 0 {
+		return nil, fmt.Errorf("no players found in %s", strings.Join(paths, ", "))
+	}
+	return merged, nil
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"os"            // Package for writing the test files
+	"path/filepath" // Package for building file paths
+	"strings"       // Package for string manipulation functions
+	"testing"       // Package for Go tests
+)
+
+// writeTestFile writes a file into the test's temporary directory and returns its path
+func writeTestFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// TestLoadPlayerFilesMerges checks that repeated -players-file values merge in order, with a
+// player loaded twice (same ID, or same name without one) taken from the later file
+func TestLoadPlayerFilesMerges(t *testing.T) {
+	legends := writeTestFile(t, "legends.json", `[
+		{"id": 1, "name": "Michael Jordan", "team": "Retired", "position": "SG"},
+		{"name": "Larry Bird", "team": "Retired", "position": "SF"},
+		{"id": 3, "name": "Kobe Bryant", "team": "Retired", "position": "SG"}
+	]`)
+	current := writeTestFile(t, "current.json", `[
+		{"id": 3, "name": "Kobe Bryant", "team": "Los Angeles Lakers", "position": "SG"},
+		{"name": "LARRY BIRD", "team": "Boston Celtics", "position": "SF"},
+		{"id": 4, "name": "Larry Bird", "team": "Indiana Pacers", "position": "SF"},
+		{"id": 5, "name": "Stephen Curry", "team": "Golden State Warriors", "position": "PG"}
+	]`)
+
+	merged, err := loadPlayerFiles([]string{legends, current}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct{ name, team string }{
+		{"Michael Jordan", "Retired"},
+		{"LARRY BIRD", "Boston Celtics"},      // Same name, no ID: the later copy, in the earlier place
+		{"Kobe Bryant", "Los Angeles Lakers"}, // Same ID: the later copy
+		{"Larry Bird", "Indiana Pacers"},      // Same name but an ID of its own: a different player
+		{"Stephen Curry", "Golden State Warriors"},
+	}
+	if len(merged) != len(want) {
+		t.Fatalf("merged %d players, want %d: %v", len(merged), len(want), merged)
+	}
+	for i, player := range merged {
+		if player.Name != want[i].name || player.Team != want[i].team {
+			t.Errorf("player %d = %s (%s), want %s (%s)", i+1, player.Name, player.Team, want[i].name, want[i].team)
+		}
+	}
+
+	// Reversing the files reverses which copy wins
+	merged, err = loadPlayerFiles([]string{current, legends}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if merged[0].Name != "Kobe Bryant" || merged[0].Team != "Retired" {
+		t.Errorf("with the files reversed the first player is %s (%s), want Kobe Bryant (Retired)", merged[0].Name, merged[0].Team)
+	}
+}
+
+// TestLoadPlayerFilesBadFile checks that a bad file fails the whole load, naming the file
+func TestLoadPlayerFilesBadFile(t *testing.T) {
+	good := writeTestFile(t, "good.json", `[{"name": "Michael Jordan"}]`)
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		{"missing", filepath.Join(t.TempDir(), "missing.json"), "failed to read players file"},
+		{"not JSON", writeTestFile(t, "broken.json", `[{"name": `), "broken.json"},
+		{"no name", writeTestFile(t, "noname.json", `[{"name": "Ok"}, {"team": "Boston Celtics"}]`), "noname.json: player #2: missing name"},
+	}
+	for _, test := range tests {
+		_, err := loadPlayerFiles([]string{good, test.path}, nil)
+		if err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("%s: loadPlayerFiles() = %v, want an error containing %q", test.name, err, test.want)
+		}
+	}
+
+	empty := writeTestFile(t, "empty.json", `[]`)
+	if _, err := loadPlayerFiles([]string{empty}, nil); err == nil || !strings.Contains(err.Error(), "no players found") {
+		t.Errorf("an empty file: loadPlayerFiles() = %v, want a no-players error", err)
+	}
+}
+
+// TestValidatePlayerDraftYear checks that out-of-range draft years load as unknown
+func TestValidatePlayerDraftYear(t *testing.T) {
+	for year, want := range map[int]int{1946: unknownDraftYear, 2999: unknownDraftYear, 1984: 1984, unknownDraftYear: unknownDraftYear} {
+		player := Player{Name: "Test Player", DraftYear: year}
+		if err := validatePlayer(&player); err != nil {
+			t.Fatal(err)
+		}
+		if player.DraftYear != want {
+			t.Errorf("draft year %d loaded as %d, want %d", year, player.DraftYear, want)
+		}
+	}
+}
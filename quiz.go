@@ -0,0 +1,124 @@
+package main
+
+import (
+	"fmt"       // Package for formatted I/O operations
+	"math/rand" // Package for the session's random source
+	"sort"      // Package for sorting slices
+	"strings"   // Package for string manipulation functions
+)
+
+// quizPoints is the bonus a correct post-game quiz answer is worth
+const quizPoints = 1
+
+// quizChoices is how many answers a quiz question offers, the right one included
+const quizChoices = 4
+
+// quizQuestions holds the question asked about each attribute the quiz can use
+var quizQuestions = map[string]string{
+	"college":      "Which college did %s attend?",
+	"draftyear":    "In which year was %s drafted?",
+	"jerseynumber": "Which jersey number does %s wear?",
+	"country":      "Which country is %s from?",
+	"team":         "Which team does %s play for?",
+}
+
+// quizQuestion is one multiple-choice question about the mystery player
+type quizQuestion struct {
+	Prompt  string   // The question
+	Choices []string // Possible answers, shuffled
+	Answer  int      // Index of the right answer in Choices
+}
+
+// quizValue returns a player's answer to a quiz question, or "" when the data can't make a
+// fair question: unknown values, and "None" (no college) and "Free Agent", which aren't facts to recall
+func quizValue(player Player, attribute string, config GameConfig) string {
+	value := attributeValue(player, attribute, config)
+	switch value {
+	case "Unknown", "None", freeAgentTeam:
+		return ""
+	}
+	return value
+}
+
+// newQuizQuestion builds a question about one of the player's attributes, with wrong answers
+// taken from other players in the pool; it returns false when the player lacks the data for
+// every question or the pool is too small to offer enough wrong answers
+func newQuizQuestion(player Player, config GameConfig, rng *rand.Rand) (quizQuestion, bool) {
+	attributes := make([]string, 0, len(quizQuestions))
+	for attribute := range quizQuestions {
+		attributes = append(attributes, attribute)
+	}
+	sort.Strings(attributes) // Map order isn't stable; the random source alone decides
+	rng.Shuffle(len(attributes), func(i, j int) { attributes[i], attributes[j] = attributes[j], attributes[i] })
+
+	for _, attribute := range attributes {
+		answer := quizValue(player, attribute, config)
+		if answer == "" {
+			continue // Skip what the data doesn't have
+		}
+		seen := map[string]bool{answer: true}
+		var wrong []string
+		for _, other := range store.Players() {
+			if value := quizValue(other, attribute, config); value != "" && !seen[value] {
+				seen[value] = true
+				wrong = append(wrong, value)
+			}
+		}
+		if len(wrong) < quizChoices-1 {
+			continue
+		}
+		sort.Strings(wrong) // Pool order varies by source; keep draws reproducible
+		rng.Shuffle(len(wrong), func(i, j int) { wrong[i], wrong[j] = wrong[j], wrong[i] })
+
+		choices := append([]string{answer}, wrong[:quizChoices-1]...)
+		rng.Shuffle(len(choices), func(i, j int) { choices[i], choices[j] = choices[j], choices[i] })
+		prompt := quizQuestions[attribute]
+		if attribute == "team" && config.TeamMode == "iconic" {
+			prompt = "Which team is %s best known for?" // Iconic mode compares the iconic team
+		}
+		question := quizQuestion{Prompt: fmt.Sprintf(prompt, player.Name), Choices: choices}
+		for i, choice := range choices {
+			if choice == answer {
+				question.Answer = i
+			}
+		}
+		return question, true
+	}
+	return quizQuestion{}, false
+}
+
+// choiceLetter returns the letter a choice is picked by: A, B, C, ...
+func choiceLetter(i int) string {
+	return string(rune('A' + i))
+}
+
+// isRight reports whether an answer - a choice letter or the answer itself - is correct
+func (q quizQuestion) isRight(answer string) bool {
+	answer = strings.TrimSpace(answer)
+	return strings.EqualFold(answer, choiceLetter(q.Answer)) || normalizeName(answer) == normalizeName(q.Choices[q.Answer])
+}
+
+// playQuiz asks a bonus question about the revealed mystery player and returns the points
+// earned; players without the data for a question are skipped without asking anything
+func playQuiz(game *Game, input <-chan string) int {
+	question, found := newQuizQuestion(game.Target, game.Config, game.Config.rng())
+	if !found {
+		return 0
+	}
+	fmt.Printf("\n🧠 Bonus question (+%d): %s\n", quizPoints, question.Prompt)
+	for i, choice := range question.Choices {
+		fmt.Printf("  %s) %s\n", choiceLetter(i), choice)
+	}
+	fmt.Printf("Your answer (%s-%s, Enter to skip): ", choiceLetter(0), choiceLetter(len(question.Choices)-1))
+	answer, ok := <-input
+	if !ok || strings.TrimSpace(answer) == "" {
+		fmt.Printf("Skipped - it was %s.\n", question.Choices[question.Answer])
+		return 0
+	}
+	if !question.isRight(answer) {
+		fmt.Printf("❌ Not quite - it was %s.\n", question.Choices[question.Answer])
+		return 0
+	}
+	fmt.Printf("✅ Correct! +%d bonus %s.\n", quizPoints, unit(quizPoints, "point", "points"))
+	return quizPoints
+}
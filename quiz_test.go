@@ -0,0 +1,81 @@
+package main
+
+import (
+	"math/rand" // Package for the session's random source
+	"testing"   // Package for Go tests
+)
+
+// TestQuizQuestionFromPlayerData checks that questions offer distinct choices, one of them the
+// player's own value, and that players without the data for any question get none
+func TestQuizQuestionFromPlayerData(t *testing.T) {
+	players := useFallbackPlayers(t)
+	config := defaultConfig()
+
+	for seed := int64(1); seed <= 20; seed++ {
+		question, found := newQuizQuestion(players[0], config, rand.New(rand.NewSource(seed)))
+		if !found {
+			t.Fatalf("seed %d: no question for %s", seed, players[0].Name)
+		}
+		if len(question.Choices) != quizChoices {
+			t.Fatalf("seed %d: %d choices, want %d", seed, len(question.Choices), quizChoices)
+		}
+		seen := make(map[string]bool)
+		for _, choice := range question.Choices {
+			if choice == "" || seen[choice] {
+				t.Errorf("seed %d: blank or repeated choice %q in %q", seed, choice, question.Choices)
+			}
+			seen[choice] = true
+		}
+		right := false
+		for attribute := range quizQuestions {
+			if quizValue(players[0], attribute, config) == question.Choices[question.Answer] {
+				right = true
+			}
+		}
+		if !right {
+			t.Errorf("seed %d: answer %q isn't one of %s's values", seed, question.Choices[question.Answer], players[0].Name)
+		}
+	}
+
+	unknown := Player{Name: "Mystery Man", Team: freeAgentTeam, College: "None", DraftYear: unknownDraftYear, JerseyNumber: "Unknown", Country: "Unknown"}
+	if question, found := newQuizQuestion(unknown, config, rand.New(rand.NewSource(1))); found {
+		t.Errorf("a player without data got a question: %q", question.Prompt)
+	}
+
+	usePlayers(t, players[:2])
+	if question, found := newQuizQuestion(players[0], config, rand.New(rand.NewSource(1))); found {
+		t.Errorf("a pool too small for wrong answers got a question: %q", question.Prompt)
+	}
+}
+
+// TestQuizScoring checks that the right answer, by letter or by text, earns the bonus and that
+// wrong or skipped answers earn nothing
+func TestQuizScoring(t *testing.T) {
+	useFallbackPlayers(t)
+	question, found := newQuizQuestion(getFallbackPlayers()[0], defaultConfig(), rand.New(rand.NewSource(1)))
+	if !found {
+		t.Fatal("no question for the fallback target")
+	}
+	wrong := choiceLetter((question.Answer + 1) % len(question.Choices))
+
+	tests := []struct {
+		name  string
+		input []string
+		want  int
+	}{
+		{"letter", []string{choiceLetter(question.Answer)}, quizPoints},
+		{"lowercase letter", []string{" " + string(rune('a'+question.Answer)) + " "}, quizPoints},
+		{"answer text", []string{question.Choices[question.Answer]}, quizPoints},
+		{"wrong letter", []string{wrong}, 0},
+		{"skipped", []string{""}, 0},
+		{"closed input", nil, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			game, _ := newTestGame(t, func(config *GameConfig) { config.Rand = rand.New(rand.NewSource(1)) })
+			if got := playQuiz(game, inputLines(tt.input...)); got != tt.want {
+				t.Errorf("playQuiz(%q) = %d, want %d", tt.input, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"encoding/json" // Package for JSON encoding and decoding
+	"fmt"           // Package for formatted I/O operations
+	"os"            // Package for file operations
+	"time"          // Package for time-related operations
+)
+
+// replayVersion is bumped whenever the replay format changes incompatibly
+const replayVersion = 1
+
+// replayPause is how long a replay waits before showing each guess on a terminal
+const replayPause = 1500 * time.Millisecond
+
+// Replay is a compact record of one finished game: the mystery player, the settings that
+// decide the markers, and the guesses in order, so the game can be watched again
+type Replay struct {
+	Version               int      `json:"version"`                 // Format version (replayVersion)
+	Target                string   `json:"target"`                  // Mystery player's name
+	Guesses               []string `json:"guesses"`                 // Guessed players' names in order
+	Won                   bool     `json:"won"`                     // Whether the game was won
+	TeamMode              string   `json:"team_mode"`               // Team compared in clues
+	DraftYearTolerance    int      `json:"draft_year_tolerance"`    // Draft year tolerance the markers used
+	DraftPickTolerance    int      `json:"draft_pick_tolerance"`    // Draft pick tolerance the markers used
+	HeightToleranceInches int      `json:"height_tolerance_inches"` // Height tolerance the markers used
+	WeightToleranceLbs    int      `json:"weight_tolerance_lbs"`    // Weight tolerance the markers used
+}
+
+// newReplay captures a finished game
+func newReplay(game *Game, outcome GameOutcome) Replay {
+	replay := Replay{
+		Version:               replayVersion,
+		Target:                game.Target.Name,
+		Guesses:               []string{},
+		Won:                   outcome == OutcomeWon,
+		TeamMode:              game.Config.TeamMode,
+		DraftYearTolerance:    game.Config.DraftYearTolerance,
+		DraftPickTolerance:    game.Config.DraftPickTolerance,
+		HeightToleranceInches: game.Config.HeightToleranceInches,
+		WeightToleranceLbs:    game.Config.WeightToleranceLbs,
+	}
+	for _, record := range game.History {
+		replay.Guesses = append(replay.Guesses, record.Player.Name)
+	}
+	return replay
+}
+
+// writeReplay saves a finished game as a replay file
+func writeReplay(path string, game *Game, outcome GameOutcome) error {
+	data, err := json.MarshalIndent(newReplay(game, outcome), "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode replay: %v", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write replay file: %v", err)
+	}
+	return nil
+}
+
+// loadReplay reads a replay file
+func loadReplay(path string) (Replay, error) {
+	var replay Replay
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return replay, fmt.Errorf("failed to read replay file: %v", err)
+	}
+	if err := json.Unmarshal(data, &replay); err != nil {
+		return replay, fmt.Errorf("failed to parse replay file %s: %v", path, err)
+	}
+	if replay.Version != replayVersion {
+		return replay, fmt.Errorf("replay file %s has version %d, but this version of the game plays version %d", path, replay.Version, replayVersion)
+	}
+	return replay, nil
+}
+
+// replayGame rebuilds a replay's game by playing its guesses through the engine again,
+// which regenerates every comparison exactly as it was first shown
+func replayGame(replay Replay, config GameConfig) (*Game, error) {
+	config.TeamMode = replay.TeamMode
+	config.DraftYearTolerance = replay.DraftYearTolerance
+	config.DraftPickTolerance = replay.DraftPickTolerance
+	config.HeightToleranceInches = replay.HeightToleranceInches
+	config.WeightToleranceLbs = replay.WeightToleranceLbs
+
+	target, found := store.PlayerByName(normalizeName(replay.Target))
+	if !found {
+		return nil, fmt.Errorf("the replay's mystery player %s isn't in the player pool", replay.Target)
+	}
+	game := newGame(config, target)
+	for _, name := range replay.Guesses {
+		guess, found := store.PlayerByName(normalizeName(name))
+		if !found {
+			return nil, fmt.Errorf("the replay's guess %s isn't in the player pool", name)
+		}
+		game.recordGuess(guess)
+	}
+	return game, nil
+}
+
+// playReplay shows a replay guess by guess, pausing between guesses on a terminal as if watching live
+func playReplay(path string, config GameConfig) error {
+	replay, err := loadReplay(path)
+	if err != nil {
+		return err
+	}
+	game, err := replayGame(replay, config)
+	if err != nil {
+		return err
+	}
+
+	pause := time.Duration(0)
+	if isTerminal(os.Stdout) {
+		pause = replayPause // Piped replays are printed at once
+	}
+
+	fmt.Printf("\n🎬 Replay: %d guess(es)\n", len(game.History))
+	if !game.Config.Compact {
+		printHeader(game.Config)
+	}
+	for _, record := range game.History {
+		time.Sleep(pause)
+		fmt.Println(game.renderRecord(record))
+	}
+	time.Sleep(pause)
+
+	won := len(game.History) > 0 && game.isCorrect(game.History[len(game.History)-1].Player)
+	if won {
+		fmt.Printf("\n🎉 Solved in %d guess(es)! The mystery player was %s.\n", len(game.History), game.Target.Name)
+	} else {
+		fmt.Printf("\n💔 Not solved. The mystery player was %s.\n", game.Target.Name)
+	}
+	if won != replay.Won {
+		fmt.Println("Warning: the replay ended differently than it was recorded - the player data may have changed.")
+	}
+	return nil
+}
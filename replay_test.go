@@ -0,0 +1,71 @@
+package main
+
+import (
+	"path/filepath" // Package for building file paths
+	"reflect"       // Package for comparing comparison results
+	"strings"       // Package for string manipulation functions
+	"testing"       // Package for Go tests
+)
+
+// TestReplayReproducesGame checks that a recorded game replays to the same guesses, markers,
+// and outcome, even when the settings it was played with differ from the viewer's
+func TestReplayReproducesGame(t *testing.T) {
+	players := useFallbackPlayers(t)
+	for _, win := range []bool{true, false} {
+		game, _ := newTestGame(t, func(config *GameConfig) {
+			config.SaveFile = filepath.Join(t.TempDir(), "save.json")
+			config.MaxAttempts = 4
+			config.TeamMode = "iconic"
+			config.DraftYearTolerance, config.HeightToleranceInches = 5, 3 // Not the viewer's tolerances
+		})
+		lines := []string{players[1].Name, players[2].Name, players[3].Name}
+		if win {
+			lines = append(lines, game.Target.Name)
+		} else {
+			lines = append(lines, players[4].Name)
+		}
+		outcome := playLines(game, lines...)
+		if (outcome == OutcomeWon) != win {
+			t.Fatalf("recording a game meant to win=%v ended %v", win, outcome)
+		}
+
+		path := filepath.Join(t.TempDir(), "game.replay.json")
+		if err := writeReplay(path, game, outcome); err != nil {
+			t.Fatal(err)
+		}
+		replay, err := loadReplay(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if replay.Won != win {
+			t.Errorf("win=%v: the replay recorded Won %v", win, replay.Won)
+		}
+		replayed, err := replayGame(replay, defaultConfig())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if replayed.Target.Name != game.Target.Name || len(replayed.History) != len(game.History) {
+			t.Fatalf("win=%v: replayed %s with %d guesses, want %s with %d", win, replayed.Target.Name, len(replayed.History), game.Target.Name, len(game.History))
+		}
+		for i, record := range replayed.History {
+			original := game.History[i]
+			if record.Player.Name != original.Player.Name || !reflect.DeepEqual(record.Result, original.Result) {
+				t.Errorf("win=%v, guess %d: replayed %s %+v, want %s %+v", win, i+1, record.Player.Name, record.Result.Statuses, original.Player.Name, original.Result.Statuses)
+			}
+		}
+		last := replayed.History[len(replayed.History)-1].Player
+		if replayed.isCorrect(last) != win {
+			t.Errorf("win=%v: the replay's last guess %s ended it differently", win, last.Name)
+		}
+	}
+}
+
+// TestLoadReplayRejectsOtherVersions checks that a replay from an incompatible format is refused
+func TestLoadReplayRejectsOtherVersions(t *testing.T) {
+	path := writeTestFile(t, "old.replay.json", `{"version": 99, "target": "LeBron James", "guesses": []}`)
+	_, err := loadReplay(path)
+	if err == nil || !strings.Contains(err.Error(), "version 99") {
+		t.Errorf("loadReplay = %v, want a version error", err)
+	}
+}
@@ -0,0 +1,6 @@
+//go:build !linux && !darwin
+
+package main
+
+// watchResize does nothing on platforms without SIGWINCH; the width stays $COLUMNS or 80
+func watchResize() {}
@@ -0,0 +1,40 @@
+//go:build linux || darwin
+
+package main
+
+import (
+	"os"        // Package for the terminal file
+	"os/signal" // Package for receiving the resize signal
+	"syscall"   // Package for SIGWINCH and the window-size ioctl
+	"unsafe"    // Package for passing the window-size struct to ioctl
+)
+
+// windowSize mirrors the kernel's struct winsize
+type windowSize struct {
+	Rows, Columns, XPixels, YPixels uint16
+}
+
+// queryTerminalWidth asks the terminal behind stdout for its current width
+func queryTerminalWidth() (int, bool) {
+	var size windowSize
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, os.Stdout.Fd(), uintptr(syscall.TIOCGWINSZ), uintptr(unsafe.Pointer(&size)))
+	if errno != 0 || size.Columns == 0 {
+		return 0, false
+	}
+	return int(size.Columns), true
+}
+
+// watchResize re-reads the terminal width every time the window is resized (SIGWINCH),
+// so the next card or list is laid out for the new size
+func watchResize() {
+	if !isTerminal(os.Stdout) {
+		return // Piped output never resizes
+	}
+	resized := make(chan os.Signal, 1)
+	signal.Notify(resized, syscall.SIGWINCH)
+	go func() {
+		for range resized {
+			handleResize(queryTerminalWidth)
+		}
+	}()
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"fmt"     // Package for formatted I/O operations
+	"strings" // Package for string manipulation functions
+)
+
+// revealSection is one part of the end-of-game reveal: an attribute and the lines showing it
+type revealSection struct {
+	Key   string   // What the lines reveal: "name", "team", "draft", or a compared attribute
+	Lines []string // Lines shown for it
+}
+
+// ladderRevealSections maps hint ladder attributes to the reveal section showing the same fact,
+// so -reveal-order=suspense follows the ladder's weakest-to-strongest order
+var ladderRevealSections = map[string]string{
+	"continent":    "country",
+	"draftdecade":  "draftyear",
+	"division":     "team",
+	"drafttier":    "draft",
+	"draftround":   "draft",
+	"draftnumber":  "draft",
+	"jerseyrange":  "jerseynumber",
+	"position":     "position",
+	"team":         "team",
+	"height":       "height",
+	"weight":       "weight",
+	"college":      "college",
+	"draftyear":    "draftyear",
+	"jerseynumber": "jerseynumber",
+	"country":      "country",
+}
+
+// orderReveal returns the sections in the configured reveal order. The standard order keeps them
+// as given; suspense follows the hint ladder, then the remaining sections, and always ends with
+// the team and then the name, the most identifying facts
+func orderReveal(sections []revealSection, config GameConfig) []revealSection {
+	if config.RevealOrder != "suspense" {
+		return sections
+	}
+	byKey := make(map[string]revealSection)
+	for _, section := range sections {
+		byKey[section.Key] = section
+	}
+
+	var ordered []revealSection
+	placed := map[string]bool{"team": true, "name": true} // Saved for the end
+	add := func(key string) {
+		if _, found := byKey[key]; !found && key == "draftyear" {
+			key = "draft" // The card shows the draft year on its draft line
+		}
+		if section, found := byKey[key]; found && !placed[key] {
+			placed[key] = true
+			ordered = append(ordered, section)
+		}
+	}
+	for _, attribute := range config.HintLadder {
+		add(ladderRevealSections[attribute])
+	}
+	for _, section := range sections {
+		add(section.Key)
+	}
+	for _, key := range []string{"team", "name"} {
+		if section, found := byKey[key]; found {
+			ordered = append(ordered, section)
+		}
+	}
+	return ordered
+}
+
+// revealLines flattens sections into lines
+func revealLines(sections []revealSection) []string {
+	var lines []string
+	for _, section := range sections {
+		lines = append(lines, section.Lines...)
+	}
+	return lines
+}
+
+// detailSections returns the plain details list of a player, section by section, in the standard order
+func detailSections(player Player) []revealSection {
+	name := []string{fmt.Sprintf("Name: %s", player.Name)}
+	if len(player.Nicknames) > 0 {
+		name = append(name, fmt.Sprintf("Nicknames: %s", strings.Join(player.Nicknames, ", ")))
+	}
+	team := []string{fmt.Sprintf("Team: %s", player.Team)}
+	if player.ContractType == contractTwoWay {
+		team = append(team, "Contract: Two-way (splits time with the team's G League affiliate)")
+	}
+	if player.IconicTeam != "" && player.IconicTeam != player.Team {
+		team = append(team, fmt.Sprintf("Iconic Team: %s", player.IconicTeam))
+	}
+	draft := []string{"Draft Status: Undrafted"}
+	if player.DraftRound != 0 {
+		draft = []string{fmt.Sprintf("Draft Round: %d", player.DraftRound), fmt.Sprintf("Draft Pick: %d", player.DraftNumber)}
+	}
+
+	return []revealSection{
+		{"name", name},
+		{"team", team},
+		{"position", []string{fmt.Sprintf("Position: %s", player.Position)}},
+		{"height", []string{fmt.Sprintf("Height: %s", player.Height)}},
+		{"weight", []string{fmt.Sprintf("Weight: %s", formatWeight(player.Weight))}},
+		{"college", []string{fmt.Sprintf("College: %s", player.College)}},
+		{"draftyear", []string{fmt.Sprintf("Draft Year: %s", formatDraftYear(player.DraftYear))}},
+		{"draft", draft},
+		{"jerseynumber", []string{fmt.Sprintf("Jersey Number: %s", player.JerseyNumber)}},
+		{"country", []string{fmt.Sprintf("Country: %s", player.Country)}},
+	}
+}
+
+// cardSections returns the lines of a player card, section by section, in the standard order
+// Height and weight, and jersey and country, share a line unless the card is reordered, which
+// needs every fact on its own line
+func cardSections(player Player, reordered bool) []revealSection {
+	draft := "Undrafted"
+	if player.DraftRound != 0 {
+		draft = fmt.Sprintf("%s, round %d, pick #%d", formatDraftYear(player.DraftYear), player.DraftRound, player.DraftNumber)
+	}
+	team := []string{"Team:     " + player.Team}
+	if player.IconicTeam != "" && player.IconicTeam != player.Team {
+		team = append(team, "Iconic:   "+player.IconicTeam)
+	}
+	if player.ContractType == contractTwoWay {
+		team = append(team, "Contract: Two-way")
+	}
+	name := []string{strings.ToUpper(player.Name), ""}
+	if reordered {
+		name = []string{"", strings.ToUpper(player.Name)} // The name closes the card
+	}
+	if len(player.Nicknames) > 0 {
+		name = append(name, "A.k.a.:   "+strings.Join(player.Nicknames, ", "))
+	}
+
+	if !reordered {
+		// The original card layout: extras after the draft and jersey lines
+		extras := team[1:]
+		return []revealSection{
+			{"name", name[:2]},
+			{"team", team[:1]},
+			{"position", []string{"Position: " + player.Position}},
+			{"height", []string{"Height:   " + player.Height + "   Weight: " + formatWeight(player.Weight)}},
+			{"college", []string{"College:  " + player.College}},
+			{"draft", []string{"Draft:    " + draft}},
+			{"jerseynumber", []string{"Jersey:   #" + player.JerseyNumber + "   Country: " + player.Country}},
+			{"extras", append(append([]string{}, extras...), name[2:]...)},
+		}
+	}
+	return []revealSection{
+		{"name", name},
+		{"team", team},
+		{"position", []string{"Position: " + player.Position}},
+		{"height", []string{"Height:   " + player.Height}},
+		{"weight", []string{"Weight:   " + formatWeight(player.Weight)}},
+		{"college", []string{"College:  " + player.College}},
+		{"draft", []string{"Draft:    " + draft}},
+		{"jerseynumber", []string{"Jersey:   #" + player.JerseyNumber}},
+		{"country", []string{"Country:  " + player.Country}},
+	}
+}
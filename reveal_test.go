@@ -0,0 +1,52 @@
+package main
+
+import (
+	"reflect" // Package for comparing reveal orders
+	"testing" // Package for Go tests
+)
+
+// revealKeys returns the keys of the sections in order
+func revealKeys(sections []revealSection) []string {
+	keys := make([]string, len(sections))
+	for i, section := range sections {
+		keys[i] = section.Key
+	}
+	return keys
+}
+
+// TestRevealOrder checks that the standard order keeps the details as listed, while suspense
+// follows the hint ladder, then the rest, and saves the team and the name for last
+func TestRevealOrder(t *testing.T) {
+	player := getFallbackPlayers()[0]
+	tests := []struct {
+		name   string
+		order  string
+		ladder []string
+		want   []string
+	}{
+		{"standard", "standard", defaultHintLadder,
+			[]string{"name", "team", "position", "height", "weight", "college", "draftyear", "draft", "jerseynumber", "country"}},
+		{"suspense, default ladder", "suspense", defaultHintLadder,
+			[]string{"country", "draftyear", "position", "height", "weight", "college", "draft", "jerseynumber", "team", "name"}},
+		{"suspense, custom ladder", "suspense", []string{"jerseyrange", "drafttier", "college", "division"},
+			[]string{"jerseynumber", "draft", "college", "position", "height", "weight", "draftyear", "country", "team", "name"}},
+	}
+	for _, test := range tests {
+		config := defaultConfig()
+		config.RevealOrder, config.HintLadder = test.order, test.ladder
+		sections := orderReveal(detailSections(player), config)
+		if got := revealKeys(sections); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: order = %v, want %v", test.name, got, test.want)
+		}
+		if got := revealLines(sections); len(got) != len(revealLines(detailSections(player))) {
+			t.Errorf("%s: reordering changed the number of lines: %q", test.name, got)
+		}
+	}
+
+	// The reordered card has no draft year section of its own; it falls back to the draft line
+	config := defaultConfig()
+	config.RevealOrder, config.HintLadder = "suspense", []string{"draftdecade"}
+	if got := revealKeys(orderReveal(cardSections(player, true), config)); got[0] != "draft" || got[len(got)-1] != "name" {
+		t.Errorf("suspense card order = %v, want the draft line first and the name last", got)
+	}
+}
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"encoding/json" // Package for JSON encoding and decoding
+	"fmt"           // Package for formatted I/O operations
+	"os"            // Package for file operations
+	"sort"          // Package for sorting slices
+	"strings"       // Package for string manipulation functions
+	"time"          // Package for time-related operations
+)
+
+// SavedGuess identifies one guessed player in a save file
+type SavedGuess struct {
+	ID   int       `json:"id"`   // Player ID (0 when the player has no API ID)
+	Name string    `json:"name"` // Player name, used to double-check the ID
+	At   time.Time `json:"at"`   // When the guess was made
+}
+
+// savedRules are the settings that change how a game is played; a saved game only resumes
+// under the same ones, so -resume can't quietly hand out more attempts or a different mode
+type savedRules struct {
+	Mode              string `json:"mode"`                         // Game mode
+	Difficulty        string `json:"difficulty"`                   // Difficulty preset
+	MaxAttempts       int    `json:"max_attempts"`                 // Attempts allowed, after any small-pool adjustment
+	UnlimitedAttempts bool   `json:"unlimited_attempts,omitempty"` // Whether attempts were unlimited (-zen)
+	Hardcore          bool   `json:"hardcore,omitempty"`           // Whether unknown names cost an attempt
+}
+
+// rulesOf returns the rule-affecting part of a config
+func rulesOf(config GameConfig) savedRules {
+	return savedRules{
+		Mode:              config.Mode,
+		Difficulty:        config.Difficulty,
+		MaxAttempts:       config.MaxAttempts,
+		UnlimitedAttempts: config.UnlimitedAttempts,
+		Hardcore:          config.Hardcore,
+	}
+}
+
+// differences lists how the current rules differ from the saved ones, e.g. "-difficulty hard, not normal"
+func (saved savedRules) differences(current savedRules) []string {
+	var differences []string
+	if saved.Mode != current.Mode {
+		differences = append(differences, fmt.Sprintf("-mode %s, not %s", saved.Mode, current.Mode))
+	}
+	if saved.Difficulty != current.Difficulty {
+		differences = append(differences, fmt.Sprintf("-difficulty %s, not %s", saved.Difficulty, current.Difficulty))
+	}
+	if saved.UnlimitedAttempts != current.UnlimitedAttempts {
+		differences = append(differences, fmt.Sprintf("-zen %v, not %v", saved.UnlimitedAttempts, current.UnlimitedAttempts))
+	} else if !saved.UnlimitedAttempts && saved.MaxAttempts != current.MaxAttempts {
+		differences = append(differences, fmt.Sprintf("%d attempts, not %d", saved.MaxAttempts, current.MaxAttempts))
+	}
+	if saved.Hardcore != current.Hardcore {
+		differences = append(differences, fmt.Sprintf("-hardcore %v, not %v", saved.Hardcore, current.Hardcore))
+	}
+	return differences
+}
+
+// SavedGame is the on-disk representation of an interrupted game
+type SavedGame struct {
+	Rules              savedRules        `json:"rules"`                      // Settings the game was played under
+	TargetID           int               `json:"target_id"`                  // Mystery player's ID
+	TargetName         string            `json:"target_name"`                // Mystery player's name
+	Attempts           int               `json:"attempts"`                   // Guesses already used
+	HintsUsed          int               `json:"hints_used"`                 // Hints already used
+	UsedHintAttributes []string          `json:"used_hint_attributes"`       // Attributes already revealed by hints
+	Guesses            []SavedGuess      `json:"guesses"`                    // Guess history in order
+	StartTime          time.Time         `json:"start_time"`                 // When the game originally started
+	Deadline           time.Time         `json:"deadline"`                   // When the game's time limit expires
+	DailyDate          string            `json:"daily_date,omitempty"`       // Daily challenge the game belongs to
+	WeeklyKey          string            `json:"weekly_key,omitempty"`       // Weekly challenge the game belongs to
+	WeeklyDay          int               `json:"weekly_day,omitempty"`       // Puzzle day within the weekly challenge
+	StarterClue        string            `json:"starter_clue,omitempty"`     // Attribute revealed by the free starter clue
+	TimeTrades         int               `json:"time_trades,omitempty"`      // Attempts traded for extra time
+	SkippedTarget      string            `json:"skipped_target,omitempty"`   // Mystery player replaced with 'skip'
+	NameHintsShown     []int             `json:"name_hints_shown,omitempty"` // Levels of the automatic name hints already shown
+	Pinned             map[string]string `json:"pinned,omitempty"`           // Attribute values confirmed in attribute mode
+}
+
+// saveGame writes the current game state to the given file
+func saveGame(game *Game, path string) error {
+	saved := SavedGame{
+		Rules:         rulesOf(game.Config),
+		Pinned:        game.Pinned,
+		TargetID:      game.Target.ID,
+		TargetName:    game.Target.Name,
+		Attempts:      game.Attempts,
+		HintsUsed:     game.HintsUsed,
+		StartTime:     game.StartTime,
+		Deadline:      game.Deadline,
+		DailyDate:     game.DailyDate,
+		WeeklyKey:     game.WeeklyKey,
+		WeeklyDay:     game.WeeklyDay,
+		StarterClue:   game.StarterClue,
+		TimeTrades:    game.TimeTrades,
+		SkippedTarget: game.SkippedTarget,
+	}
+
+	// Store revealed attributes in sorted order so save files are stable
+	for attr := range game.UsedHintAttributes {
+		saved.UsedHintAttributes = append(saved.UsedHintAttributes, attr)
+	}
+	sort.Strings(saved.UsedHintAttributes)
+	for level := range game.NameHintsShown {
+		saved.NameHintsShown = append(saved.NameHintsShown, level)
+	}
+	sort.Ints(saved.NameHintsShown)
+
+	// Store each guess by identity only; comparisons are recomputed on resume
+	for _, record := range game.History {
+		saved.Guesses = append(saved.Guesses, SavedGuess{ID: record.Player.ID, Name: record.Player.Name, At: record.At})
+	}
+
+	data, err := json.MarshalIndent(saved, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode saved game: %v", err)
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// loadGame reads a saved game and rebuilds it against the currently loaded player pool
+func loadGame(config GameConfig, path string) (*Game, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read saved game: %v", err)
+	}
+
+	var saved SavedGame
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return nil, fmt.Errorf("failed to parse saved game: %v", err)
+	}
+
+	// Resuming under other rules would change the game mid-play, e.g. reset a hard game's attempts
+	if saved.Rules.Mode == "" {
+		return nil, fmt.Errorf("the saved game doesn't record the rules it was played with - cannot resume")
+	}
+	if differences := saved.Rules.differences(rulesOf(config)); len(differences) > 0 {
+		return nil, fmt.Errorf("the saved game was played with %s - resume it with the same settings", strings.Join(differences, "; "))
+	}
+	if !config.UnlimitedAttempts && saved.Attempts >= config.MaxAttempts {
+		return nil, fmt.Errorf("the saved game has no attempts left - cannot resume")
+	}
+
+	// The target must still exist, otherwise the saved clues are meaningless
+	target, found := findPlayerByID(saved.TargetID, saved.TargetName)
+	if !found {
+		return nil, fmt.Errorf("saved mystery player is no longer in the player pool - cannot resume")
+	}
+
+	game := &Game{
+		Config:             config,
+		Target:             *target,
+		HintsUsed:          saved.HintsUsed,
+		UsedHintAttributes: make(map[string]bool),
+		StartTime:          saved.StartTime,
+		Deadline:           saved.Deadline,
+		DailyDate:          saved.DailyDate,
+		WeeklyKey:          saved.WeeklyKey,
+		WeeklyDay:          saved.WeeklyDay,
+		StarterClue:        saved.StarterClue,
+		TimeTrades:         saved.TimeTrades,
+		SkippedTarget:      saved.SkippedTarget,
+		NameHintsShown:     make(map[int]bool),
+		PaceStart:          config.clock().Now(), // The pace window restarts when the game is resumed
+		Clock:              config.clock(),
+	}
+	if config.NoTimeLimit {
+		game.Deadline = time.Time{} // Resuming without a time limit (e.g. -async) drops the saved deadline
+	}
+	for _, attr := range saved.UsedHintAttributes {
+		game.UsedHintAttributes[attr] = true
+	}
+	if len(saved.Pinned) > 0 {
+		game.Pinned = saved.Pinned
+	}
+	for _, level := range saved.NameHintsShown {
+		game.NameHintsShown[level] = true // Hints already seen don't fire again after resuming
+	}
+
+	// Replay the guess history so the comparisons reflect the current data
+	for _, guess := range saved.Guesses {
+		player, found := findPlayerByID(guess.ID, guess.Name)
+		if !found {
+			return nil, fmt.Errorf("saved guess %q is no longer in the player pool - cannot resume", guess.Name)
+		}
+		game.recordGuess(*player)
+		game.History[len(game.History)-1].At = guess.At // Keep the original timing for -time-splits
+	}
+
+	// Trust the saved counter if it disagrees with the replayed history
+	game.Attempts = saved.Attempts
+	return game, nil
+}
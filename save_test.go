@@ -0,0 +1,94 @@
+package main
+
+import (
+	"path/filepath" // Package for building the save file path
+	"strings"       // Package for string manipulation functions
+	"testing"       // Package for Go tests
+	"time"          // Package for time-related operations
+)
+
+// savedTestGame plays two misses and a hint in a game, saves it, and returns the save path
+func savedTestGame(t *testing.T, change func(*GameConfig)) (*Game, string) {
+	t.Helper()
+	useFallbackPlayers(t)
+	game, clock := newTestGame(t, change)
+	path := filepath.Join(t.TempDir(), "save.json")
+	game.Config.SaveFile = path
+	for i := 0; i < 2; i++ {
+		clock.Advance(10 * time.Second)
+		game.recordGuess(testGuess(game))
+	}
+	game.HintsUsed = 1
+	game.UsedHintAttributes["college"] = true
+	game.NameHintsShown[1] = true
+	if err := saveGame(game, path); err != nil {
+		t.Fatal(err)
+	}
+	return game, path
+}
+
+// TestSaveLoadRoundTrip checks that a resumed game picks up exactly where it was saved
+func TestSaveLoadRoundTrip(t *testing.T) {
+	game, path := savedTestGame(t, func(c *GameConfig) { c.Mode = "attributes" })
+	game.Pinned = map[string]string{"position": "SF"}
+	if err := saveGame(game, path); err != nil {
+		t.Fatal(err)
+	}
+
+	resumed, err := loadGame(game.Config, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resumed.Target.Name != game.Target.Name || resumed.Attempts != game.Attempts || resumed.HintsUsed != game.HintsUsed {
+		t.Errorf("resumed %s with %d attempts and %d hints, want %s with %d and %d",
+			resumed.Target.Name, resumed.Attempts, resumed.HintsUsed, game.Target.Name, game.Attempts, game.HintsUsed)
+	}
+	if !resumed.StartTime.Equal(game.StartTime) || !resumed.Deadline.Equal(game.Deadline) {
+		t.Error("the clock was reset on resume")
+	}
+	if len(resumed.History) != len(game.History) {
+		t.Fatalf("resumed %d guesses, want %d", len(resumed.History), len(game.History))
+	}
+	for i, record := range resumed.History {
+		if record.Player.Name != game.History[i].Player.Name || !record.At.Equal(game.History[i].At) {
+			t.Errorf("guess %d resumed as %s at %v, want %s at %v", i+1, record.Player.Name, record.At, game.History[i].Player.Name, game.History[i].At)
+		}
+	}
+	if !resumed.UsedHintAttributes["college"] || !resumed.NameHintsShown[1] || resumed.Pinned["position"] != "SF" {
+		t.Errorf("lost revealed state: hints %v, name hints %v, pinned %v", resumed.UsedHintAttributes, resumed.NameHintsShown, resumed.Pinned)
+	}
+}
+
+// TestLoadRejectsOtherRules checks that a save never resumes under rules it wasn't played with
+func TestLoadRejectsOtherRules(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(*GameConfig)
+		want   string
+	}{
+		{"difficulty", func(c *GameConfig) { c.applyDifficulty("easy") }, "-difficulty normal, not easy"},
+		{"mode", func(c *GameConfig) { c.Mode = "attributes" }, "-mode player, not attributes"},
+		{"attempts", func(c *GameConfig) { c.MaxAttempts = 20 }, "8 attempts, not 20"},
+		{"zen", func(c *GameConfig) { c.UnlimitedAttempts = true }, "-zen false, not true"},
+		{"hardcore", func(c *GameConfig) { c.Hardcore = true }, "-hardcore false, not true"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			game, path := savedTestGame(t, nil)
+			config := game.Config
+			test.change(&config)
+			_, err := loadGame(config, path)
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("loadGame() = %v, want an error containing %q", err, test.want)
+			}
+		})
+	}
+}
+
+// TestLoadRejectsFinishedGame checks that a save with every attempt spent can't be resumed
+func TestLoadRejectsFinishedGame(t *testing.T) {
+	game, path := savedTestGame(t, func(c *GameConfig) { c.MaxAttempts = 2 })
+	if _, err := loadGame(game.Config, path); err == nil || !strings.Contains(err.Error(), "no attempts left") {
+		t.Errorf("loadGame() = %v, want a no-attempts-left error", err)
+	}
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"fmt"  // Package for formatted I/O operations
+	"time" // Package for time-related operations
+)
+
+// session holds what carries over from one game to the next in a run of games: the clock it
+// is timed on, the endurance budget every game shares, and the -session-time cap
+type session struct {
+	Config            GameConfig // Settings the session started with
+	Start             time.Time  // When the session's first game started
+	EnduranceDeadline time.Time  // When the endurance budget runs out (zero outside endurance)
+}
+
+// newSession starts a session with its first game; endurance games all share that game's deadline
+func newSession(config GameConfig, first *Game) *session {
+	s := &session{Config: config, Start: config.clock().Now()}
+	if config.Endurance > 0 {
+		s.EnduranceDeadline = first.Deadline
+	}
+	return s
+}
+
+// nextGame starts the session's next game against the target; in endurance the attempts
+// reset but the clock doesn't
+func (s *session) nextGame(config GameConfig, target Player) *Game {
+	game := newGame(config, target)
+	if config.Endurance > 0 {
+		game.Deadline = s.EnduranceDeadline
+	}
+	return game
+}
+
+// elapsed returns how long the session has been running
+func (s *session) elapsed() time.Duration {
+	return s.Config.clock().Now().Sub(s.Start)
+}
+
+// timeUp reports whether the -session-time cap has been reached
+func (s *session) timeUp() bool {
+	return s.Config.SessionTime > 0 && s.elapsed() >= s.Config.SessionTime
+}
+
+// playAgain asks whether to start another game; the session cap ends the session instead,
+// whether it was reached during the last game or while the question was waiting for an answer
+// (a game in progress when the cap hits is played out, but no new one starts after it)
+func (s *session) playAgain(input <-chan string) bool {
+	if s.timeUp() {
+		fmt.Printf("\n⏱️ Session time is up (%s).\n", formatDuration(s.Config.SessionTime, s.Config))
+		return false
+	}
+	var deadline <-chan time.Time // Never fires without a cap
+	if s.Config.SessionTime > 0 {
+		left := s.Config.SessionTime - s.elapsed()
+		deadline = s.Config.clock().After(left)
+		fmt.Printf("\n%s of session time left.", formatTimeRemaining(left))
+	}
+	fmt.Print("\nPlay again? (y/n): ")
+	answer, ok := waitForInput(input, deadline)
+	if !ok && s.timeUp() {
+		fmt.Printf("\n⏱️ Session time is up (%s).\n", formatDuration(s.Config.SessionTime, s.Config))
+		return false
+	}
+	if !ok || !isYes(answer) {
+		return false
+	}
+	if s.timeUp() {
+		fmt.Printf("\n⏱️ Session time is up (%s).\n", formatDuration(s.Config.SessionTime, s.Config))
+		return false
+	}
+	return true
+}
+
+// enduranceOver reports whether an endurance run ends after a game: time ran out during the
+// puzzle, or the budget was spent by the time it was solved
+func (s *session) enduranceOver(outcome GameOutcome) bool {
+	return outcome == OutcomeTimeUp || s.Config.clock().Now().After(s.EnduranceDeadline)
+}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"testing" // Package for Go tests
+	"time"    // Package for time-related operations
+)
+
+// newTestEndurance starts an endurance session of the given budget on a fake clock
+func newTestEndurance(t *testing.T, budget time.Duration) (*session, *Game, *fakeClock) {
+	t.Helper()
+	first, clock := newTestGame(t, func(c *GameConfig) {
+		c.Endurance = budget
+		c.applyModes() // Endurance sets the time limit to the budget
+	})
+	return newSession(first.Config, first), first, clock
+}
+
+// TestEnduranceBudgetCarriesOver checks that each new puzzle gets fresh attempts but only
+// the time the earlier puzzles left over
+func TestEnduranceBudgetCarriesOver(t *testing.T) {
+	run, first, clock := newTestEndurance(t, 2*time.Minute)
+	first.recordGuess(testGuess(first))
+	clock.Advance(50 * time.Second)
+	if run.enduranceOver(OutcomeWon) {
+		t.Fatal("the run ended with budget left")
+	}
+
+	next := run.nextGame(first.Config, getFallbackPlayers()[1])
+	if next.Attempts != 0 {
+		t.Errorf("the next puzzle starts with %d attempts used", next.Attempts)
+	}
+	if got := next.timeRemaining(); got != 70*time.Second {
+		t.Errorf("the next puzzle has %v left, want the 1m10s the first one left over", got)
+	}
+	if !next.StartTime.Equal(clock.Now()) {
+		t.Errorf("the next puzzle's own clock started at %v, want %v", next.StartTime, clock.Now())
+	}
+}
+
+// TestEnduranceRunsOutMidPuzzle checks that a puzzle still being played when the budget
+// runs out ends the game and the run
+func TestEnduranceRunsOutMidPuzzle(t *testing.T) {
+	run, first, clock := newTestEndurance(t, time.Minute)
+	clock.Advance(40 * time.Second)
+	game := run.nextGame(first.Config, getFallbackPlayers()[1])
+	game.recordGuess(testGuess(game))
+	clock.Advance(21 * time.Second)
+
+	input := make(chan string)
+	close(input)
+	outcome := playGame(game, input)
+	if outcome != OutcomeTimeUp {
+		t.Fatalf("playGame() = %v after the budget ran out, want OutcomeTimeUp", outcome)
+	}
+	if !run.enduranceOver(outcome) {
+		t.Error("the run went on after its budget ran out")
+	}
+}
+
+// TestEnduranceSolvedAtTheBuzzer checks that a puzzle solved after the budget ran out
+// still ends the run
+func TestEnduranceSolvedAtTheBuzzer(t *testing.T) {
+	run, _, clock := newTestEndurance(t, time.Minute)
+	clock.Advance(time.Minute + time.Second)
+	if !run.enduranceOver(OutcomeWon) {
+		t.Error("the run went on after its budget ran out")
+	}
+}
+
+// newTestSession starts a session capped at the given time on a fake clock
+func newTestSession(t *testing.T, limit time.Duration) (*session, *fakeClock) {
+	t.Helper()
+	first, clock := newTestGame(t, func(c *GameConfig) { c.SessionTime = limit })
+	return newSession(first.Config, first), clock
+}
+
+// TestPlayAgainBeforeTheCap checks that the prompt is answered normally while time is left
+func TestPlayAgainBeforeTheCap(t *testing.T) {
+	run, clock := newTestSession(t, 10*time.Minute)
+	clock.Advance(9 * time.Minute)
+	input := make(chan string, 2)
+	input <- "y"
+	input <- "n"
+	if !run.playAgain(input) {
+		t.Error("'y' with time left didn't start another game")
+	}
+	if run.playAgain(input) {
+		t.Error("'n' started another game")
+	}
+}
+
+// TestPlayAgainStopsAtTheCap checks that no prompt is shown once the cap has passed, and that
+// a yes given after the cap passed doesn't start another game
+func TestPlayAgainStopsAtTheCap(t *testing.T) {
+	run, clock := newTestSession(t, 10*time.Minute)
+	clock.Advance(10 * time.Minute)
+	input := make(chan string, 1)
+	input <- "y"
+	if run.playAgain(input) {
+		t.Error("another game started after the session cap")
+	}
+	if len(input) != 1 {
+		t.Error("the prompt read an answer after the session cap")
+	}
+
+	// The cap passes while the question waits for an answer that never comes
+	run, clock = newTestSession(t, 10*time.Minute)
+	clock.Advance(9 * time.Minute)
+	done := make(chan bool)
+	go func() { done <- run.playAgain(make(chan string)) }()
+	clock.Advance(2 * time.Minute)
+	select {
+	case again := <-done:
+		if again {
+			t.Error("another game started after the session cap")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the prompt kept waiting after the session cap")
+	}
+}
+
+// TestSessionWithoutCap checks that an uncapped session never runs out
+func TestSessionWithoutCap(t *testing.T) {
+	run, clock := newTestSession(t, 0)
+	clock.Advance(24 * time.Hour)
+	if run.timeUp() {
+		t.Error("a session without -session-time timed out")
+	}
+	if got := run.elapsed(); got != 24*time.Hour {
+		t.Errorf("elapsed() = %v, want 24h", got)
+	}
+}
+
+// TestPlayAgainLoop drives the play-again question the way the game loop does: each yes starts
+// a game against a new mystery player, and a no or the end of input stops
+func TestPlayAgainLoop(t *testing.T) {
+	useFallbackPlayers(t)
+	first, _ := newTestGame(t, nil)
+	run := newSession(first.Config, first)
+
+	input := make(chan string, 3)
+	input <- "y"
+	input <- "YES"
+	input <- "nope"
+	game, games := first, 1
+	for run.playAgain(input) {
+		target, err := getNextRandomPlayer(game.Target, first.Config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if samePlayer(target, game.Target) {
+			t.Errorf("game %d repeated the mystery player %s", games+1, target.Name)
+		}
+		game = run.nextGame(first.Config, target)
+		games++
+	}
+	if games != 3 {
+		t.Errorf("played %d games, want 3 (two yeses, then a no)", games)
+	}
+
+	close(input)
+	if run.playAgain(input) {
+		t.Error("the end of input started another game")
+	}
+}
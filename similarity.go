@@ -0,0 +1,64 @@
+package main
+
+import "fmt" // Package for formatted I/O operations
+
+// defaultSimilarityWeights rates shared attributes: a shared team says far more about a
+// player than a shared country
+var defaultSimilarityWeights = map[string]int{
+	"team":         5,
+	"position":     3,
+	"college":      3,
+	"height":       2,
+	"draftyear":    2,
+	"weight":       1,
+	"draftround":   1,
+	"draftnumber":  1,
+	"jerseynumber": 1,
+	"country":      1,
+}
+
+// Similarity scores how alike two players are with the default weights and tolerances
+// Every feature that ranks players by likeness should use this metric
+func Similarity(a, b Player) int {
+	return similarity(a, b, defaultConfig())
+}
+
+// similarity scores how alike two players are using the config's weights and tolerances:
+// an exact attribute earns twice its weight, a close one earns its weight
+func similarity(a, b Player, config GameConfig) int {
+	result := compareWithTarget(a, b, config)
+	score := 0
+	for attribute, weight := range config.SimilarityWeights {
+		switch result.Statuses[attribute] {
+		case MatchExact:
+			score += 2 * weight
+		case MatchClose:
+			score += weight
+		}
+	}
+	return score
+}
+
+// heatPercent returns how close a guess is to the target overall, from 0 to 100: the guess's
+// similarity as a share of the target's similarity to itself, so attributes that can't be
+// compared don't count against the guess
+func heatPercent(guess, target Player, config GameConfig) int {
+	best := similarity(target, target, config)
+	if best == 0 {
+		return 0
+	}
+	return similarity(guess, target, config) * 100 / best
+}
+
+// describeHeat formats a closeness percentage as a heat indicator, e.g. "🔥 78% warm"
+func describeHeat(percent int) string {
+	switch {
+	case percent >= 90:
+		return fmt.Sprintf("🔥 %d%% hot", percent)
+	case percent >= 70:
+		return fmt.Sprintf("🔥 %d%% warm", percent)
+	case percent >= 40:
+		return fmt.Sprintf("🌡️  %d%% lukewarm", percent)
+	}
+	return fmt.Sprintf("🧊 %d%% cold", percent)
+}
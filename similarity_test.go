@@ -0,0 +1,87 @@
+package main
+
+import (
+	"maps"    // Package for copying the weight map
+	"testing" // Package for Go tests
+)
+
+// TestSimilarityScores pins the default score for known pairs: an exact attribute earns twice
+// its weight and a close one earns its weight
+func TestSimilarityScores(t *testing.T) {
+	lebron := getFallbackPlayers()[0]
+	otherTeam, _ := comparePlayers(func(p *Player) { p.Team = "Boston Celtics" }, nil)
+	closeHeight, _ := comparePlayers(func(p *Player) {
+		p.Team = "Boston Celtics"
+		p.Height = "6'10\"" // LeBron is 6'9"
+	}, nil)
+	tests := []struct {
+		name string
+		a    Player
+		want int
+	}{
+		{"the same player", lebron, 40},
+		{"another team", otherTeam, 30},
+		{"another team, an inch taller", closeHeight, 28},
+	}
+	for _, test := range tests {
+		if got := Similarity(test.a, lebron); got != test.want {
+			t.Errorf("%s: Similarity = %d, want %d", test.name, got, test.want)
+		}
+	}
+}
+
+// TestSimilarityWeightsChangeRanking checks that raising a weight reorders which player is
+// more alike
+func TestSimilarityWeightsChangeRanking(t *testing.T) {
+	_, target := comparePlayers(nil, nil)
+	teamMiss, _ := comparePlayers(func(p *Player) { p.Team = "Boston Celtics" }, nil)
+	roleMiss, _ := comparePlayers(func(p *Player) { p.Position, p.College = "C", "Duke" }, nil)
+
+	config := defaultConfig()
+	if similarity(teamMiss, target, config) <= similarity(roleMiss, target, config) {
+		t.Fatal("with the default weights, a different team should rank closer than a different position and college")
+	}
+	config.SimilarityWeights = maps.Clone(defaultSimilarityWeights)
+	config.SimilarityWeights["team"] = 10
+	if similarity(teamMiss, target, config) >= similarity(roleMiss, target, config) {
+		t.Error("with team weighted 10, a different team should rank below a different position and college")
+	}
+	if defaultSimilarityWeights["team"] != 5 {
+		t.Fatal("the test changed the default weights")
+	}
+}
+
+// TestHeatPercent checks that the mystery player scores 100%, a player who shares nothing
+// scores 0%, and a partial match lands in between
+func TestHeatPercent(t *testing.T) {
+	_, target := comparePlayers(nil, nil)
+	config := defaultConfig()
+
+	opposite, _ := comparePlayers(func(p *Player) {
+		p.Team, p.Position, p.College, p.Country = "Boston Celtics", "PG", "Duke", "France"
+		p.Height, p.Weight = "5'10\"", 160
+		p.DraftYear, p.DraftRound, p.DraftNumber = 2023, 2, 55
+		p.JerseyNumber = "99"
+	}, nil)
+	halfway, _ := comparePlayers(func(p *Player) { p.Team, p.Position, p.College = "Boston Celtics", "PG", "Duke" }, nil)
+
+	tests := []struct {
+		name     string
+		guess    Player
+		min, max int
+	}{
+		{"the mystery player", target, 100, 100},
+		{"a player with the same attributes", func() Player { p := target; p.Name = "Twin"; return p }(), 100, 100},
+		{"a player sharing nothing", opposite, 0, 0},
+		{"a player sharing all but team, position, and college", halfway, 45, 45},
+	}
+	for _, test := range tests {
+		got := heatPercent(test.guess, target, config)
+		if got < test.min || got > test.max {
+			t.Errorf("%s: heatPercent = %d, want %d-%d", test.name, got, test.min, test.max)
+		}
+	}
+	if got := describeHeat(heatPercent(opposite, target, config)); got != "🧊 0% cold" {
+		t.Errorf("describeHeat for a player sharing nothing = %q", got)
+	}
+}
@@ -0,0 +1,219 @@
+package main
+
+import (
+	"encoding/json" // Package for JSON encoding and decoding
+	"fmt"           // Package for formatted I/O operations
+	"math/rand"     // Package for generating random numbers
+	"os"            // Package for file operations
+	"path/filepath" // Package for building file paths
+	"sort"          // Package for sorting the analytics report
+	"strconv"       // Package for converting strings to numbers
+	"strings"       // Package for string manipulation functions
+	"time"          // Package for time-related operations
+)
+
+// DailyResult records how a daily challenge was finished
+type DailyResult struct {
+	Won      bool `json:"won"`             // Whether the mystery player was guessed
+	Attempts int  `json:"attempts"`        // Guesses used
+	Zen      bool `json:"zen"`             // Played without timer or attempt limit
+	Score    int  `json:"score,omitempty"` // Points earned (weekly puzzles only)
+}
+
+// PlayerStats counts how often one player came up across games recorded with -track-players
+type PlayerStats struct {
+	Guessed int `json:"guessed"` // Times the player was guessed
+	Target  int `json:"target"`  // Times the player was the mystery player
+	Solved  int `json:"solved"`  // Times the player was the mystery player and was guessed
+}
+
+// Stats is the persistent record kept between sessions
+type Stats struct {
+	Daily   map[string]DailyResult   `json:"daily"`                    // Daily challenge results keyed by UTC date
+	Players map[string]*PlayerStats  `json:"players,omitempty"`        // Per-player frequencies keyed by name (only with -track-players)
+	Weekly  map[string]*WeeklyResult `json:"weekly,omitempty"`         // Weekly challenge results keyed by UTC ISO week
+	Recent  []string                 `json:"recent_targets,omitempty"` // Names of the latest random mystery players, oldest first
+}
+
+// maxRecentTargets is how many recent mystery players the stats file keeps, the largest useful -avoid-recent
+const maxRecentTargets = 100
+
+// maxAnalyticsRows is how many players each section of the -analytics report lists
+const maxAnalyticsRows = 10
+
+// defaultStatsFile returns ~/.hoop-detective/stats.json, or a local file if the home directory is unknown
+func defaultStatsFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ".hoop-detective-stats.json"
+	}
+	return filepath.Join(home, ".hoop-detective", "stats.json")
+}
+
+// loadStats reads the stats file, returning empty stats if it doesn't exist yet
+func loadStats(path string) (*Stats, error) {
+	stats := &Stats{Daily: make(map[string]DailyResult), Players: make(map[string]*PlayerStats), Weekly: make(map[string]*WeeklyResult)}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return stats, nil // First run - nothing recorded yet
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read stats file: %v", err)
+	}
+	if err := json.Unmarshal(data, stats); err != nil {
+		return nil, fmt.Errorf("failed to parse stats file %s: %v", path, err)
+	}
+	if stats.Daily == nil {
+		stats.Daily = make(map[string]DailyResult)
+	}
+	if stats.Players == nil {
+		stats.Players = make(map[string]*PlayerStats)
+	}
+	if stats.Weekly == nil {
+		stats.Weekly = make(map[string]*WeeklyResult)
+	}
+	return stats, nil
+}
+
+// save writes the stats file, creating its directory if needed
+func (s *Stats) save(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create stats directory: %v", err)
+	}
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode stats: %v", err)
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// dailyKey returns the UTC date a daily challenge belongs to, e.g. "2024-03-15"
+func dailyKey(now time.Time) string {
+	return now.UTC().Format("2006-01-02")
+}
+
+// dailySeed turns a daily key into a random seed, e.g. "2024-03-15" -> 20240315
+func dailySeed(key string) int64 {
+	seed, _ := strconv.ParseInt(strings.ReplaceAll(key, "-", ""), 10, 64)
+	return seed
+}
+
+// getDailyPlayer returns the mystery player for the given daily key; everyone gets the same one
+func getDailyPlayer(key string, config GameConfig) (Player, error) {
+	players := eligibleTargets(store.Players(), config)
+	if len(players) == 0 {
+		return Player{}, noTargetsError(store.Players(), config)
+	}
+	rng := rand.New(rand.NewSource(dailySeed(key)))
+	return players[rng.Intn(len(players))], nil
+}
+
+// recordDailyResult stores a finished daily game so it can't be played again that day
+func recordDailyResult(game *Game, outcome GameOutcome) error {
+	stats, err := loadStats(game.Config.StatsFile)
+	if err != nil {
+		return err
+	}
+	stats.Daily[game.DailyDate] = DailyResult{
+		Won:      outcome == OutcomeWon,
+		Attempts: game.Attempts,
+		Zen:      game.Config.NoTimeLimit && game.Config.UnlimitedAttempts,
+	}
+	return stats.save(game.Config.StatsFile)
+}
+
+// playerStats returns the frequency record for a player, creating it on first use
+func (s *Stats) playerStats(name string) *PlayerStats {
+	record, found := s.Players[name]
+	if !found {
+		record = &PlayerStats{}
+		s.Players[name] = record
+	}
+	return record
+}
+
+// recordGame adds one finished game to the per-player frequencies
+func (s *Stats) recordGame(target Player, guesses []GuessRecord, won bool) {
+	for _, guess := range guesses {
+		s.playerStats(guess.Player.Name).Guessed++
+	}
+	record := s.playerStats(target.Name)
+	record.Target++
+	if won {
+		record.Solved++
+	}
+}
+
+// recordPlayerStats stores who was guessed and who the mystery player was in the stats file
+func recordPlayerStats(game *Game, outcome GameOutcome) error {
+	stats, err := loadStats(game.Config.StatsFile)
+	if err != nil {
+		return err
+	}
+	stats.recordGame(game.Target, game.History, outcome == OutcomeWon)
+	return stats.save(game.Config.StatsFile)
+}
+
+// printAnalytics reports the most-guessed players and the win rate against each mystery player
+func printAnalytics(stats *Stats) {
+	if len(stats.Players) == 0 {
+		fmt.Println("📊 No player analytics recorded yet. Play with -track-players to start collecting them.")
+		return
+	}
+	names := make([]string, 0, len(stats.Players))
+	for name := range stats.Players {
+		names = append(names, name)
+	}
+
+	// Most-guessed players first, ties in alphabetical order
+	sort.Slice(names, func(i, j int) bool {
+		a, b := stats.Players[names[i]], stats.Players[names[j]]
+		if a.Guessed != b.Guessed {
+			return a.Guessed > b.Guessed
+		}
+		return names[i] < names[j]
+	})
+	fmt.Println("📊 Most-guessed players:")
+	if stats.Players[names[0]].Guessed == 0 {
+		fmt.Println("  (no guesses recorded yet)")
+	}
+	for i, name := range names {
+		if i == maxAnalyticsRows || stats.Players[name].Guessed == 0 {
+			break
+		}
+		fmt.Printf("  %2d. %-25s %s guess(es)\n", i+1, name, formatCount(stats.Players[name].Guessed))
+	}
+
+	// Mystery players that came up most often first, with how often they were solved
+	sort.Slice(names, func(i, j int) bool {
+		a, b := stats.Players[names[i]], stats.Players[names[j]]
+		if a.Target != b.Target {
+			return a.Target > b.Target
+		}
+		return names[i] < names[j]
+	})
+	fmt.Println("\n🎯 Win rate per mystery player:")
+	for i, name := range names {
+		record := stats.Players[name]
+		if i == maxAnalyticsRows || record.Target == 0 {
+			break
+		}
+		fmt.Printf("  %2d. %-25s %d/%d won (%d%%)\n", i+1, name, record.Solved, record.Target, record.Solved*100/record.Target)
+	}
+}
+
+// rememberTarget adds a random mystery player to the recent targets in the config and the
+// stats file, so the next games avoid them
+func rememberTarget(config *GameConfig, target Player) error {
+	config.RecentTargets = append(config.RecentTargets, target.Name)
+	if len(config.RecentTargets) > maxRecentTargets {
+		config.RecentTargets = config.RecentTargets[len(config.RecentTargets)-maxRecentTargets:]
+	}
+	stats, err := loadStats(config.StatsFile)
+	if err != nil {
+		return err
+	}
+	stats.Recent = config.RecentTargets
+	return stats.save(config.StatsFile)
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"path/filepath" // Package for building file paths
+	"testing"       // Package for Go tests
+	"time"          // Package for time-related operations
+)
+
+// TestDailyRecordedInZen checks that a daily played in zen mode is still recorded, so it
+// can't be played again that day
+func TestDailyRecordedInZen(t *testing.T) {
+	useFallbackPlayers(t)
+	config, err := parseTestFlags(t, "-daily", "-zen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !config.NoTimeLimit || !config.UnlimitedAttempts {
+		t.Fatalf("-zen left limits on: NoTimeLimit %v, UnlimitedAttempts %v", config.NoTimeLimit, config.UnlimitedAttempts)
+	}
+	config.StatsFile = filepath.Join(t.TempDir(), "stats.json")
+	key := dailyKey(time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC))
+	target, err := getDailyPlayer(key, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	game := newGame(config, target)
+	game.DailyDate = key
+
+	outcome := playLines(game, testGuess(game).Name, target.Name)
+	if outcome != OutcomeWon {
+		t.Fatalf("guessing the daily player: %v, want a win", outcome)
+	}
+	if err := recordDailyResult(game, outcome); err != nil {
+		t.Fatal(err)
+	}
+	stats, err := loadStats(config.StatsFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, played := stats.Daily[key]
+	if !played {
+		t.Fatalf("the zen daily for %s wasn't recorded", key)
+	}
+	if !result.Won || !result.Zen || result.Attempts != 2 {
+		t.Errorf("recorded %+v, want a zen win in 2 attempts", result)
+	}
+}
+
+// TestPlayerStatsAcrossGames checks that per-player frequencies add up across several games
+// saved to and reloaded from the stats file
+func TestPlayerStatsAcrossGames(t *testing.T) {
+	players := useFallbackPlayers(t)
+	statsFile := filepath.Join(t.TempDir(), "stats.json")
+	a, b, c := players[0], players[1], players[2]
+
+	games := []struct {
+		target  Player
+		guesses []Player
+		outcome GameOutcome
+	}{
+		{a, []Player{b, c, a}, OutcomeWon},
+		{a, []Player{b}, OutcomeLost},
+		{b, []Player{c, b}, OutcomeWon},
+	}
+	for _, g := range games {
+		game, _ := newTestGame(t, func(config *GameConfig) { config.StatsFile = statsFile })
+		game.Target = g.target
+		for _, guess := range g.guesses {
+			game.recordGuess(guess)
+		}
+		if err := recordPlayerStats(game, g.outcome); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	stats, err := loadStats(statsFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]PlayerStats{
+		a.Name: {Guessed: 1, Target: 2, Solved: 1},
+		b.Name: {Guessed: 3, Target: 1, Solved: 1},
+		c.Name: {Guessed: 2},
+	}
+	if len(stats.Players) != len(want) {
+		t.Errorf("recorded %d players, want %d", len(stats.Players), len(want))
+	}
+	for name, record := range want {
+		got, found := stats.Players[name]
+		if !found {
+			t.Errorf("%s wasn't recorded", name)
+			continue
+		}
+		if *got != record {
+			t.Errorf("%s: recorded %+v, want %+v", name, *got, record)
+		}
+	}
+}
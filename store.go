@@ -0,0 +1,109 @@
+package main
+
+import (
+	"sync" // Package for synchronization primitives
+	"time" // Package for time-related operations
+)
+
+// Store holds the loaded player pool and the API response cache behind a lock,
+// so several games can read the pool while it is being refreshed
+type Store struct {
+	mu          sync.RWMutex   // Guards every field below
+	players     []Player       // Player pool used by the game
+	names       []string       // Normalized name of each player, parallel to players
+	nameIndex   map[string]int // Normalized name -> index of the first player with that name
+	targetKey   string         // Selection settings the cached targets were computed for
+	targets     []Player       // Cached random-target candidates for targetKey (nil when stale)
+	apiCache    []Player       // Last successful API result
+	cacheExpiry time.Time      // Timestamp when the API cache expires
+}
+
+// store is the package-wide player store
+var store = &Store{}
+
+// Players returns the current player pool
+// The slice is replaced, never modified in place, so callers may read it without holding the lock
+func (s *Store) Players() []Player {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.players
+}
+
+// SetPlayers replaces the player pool and rebuilds the name index
+func (s *Store) SetPlayers(players []Player) {
+	// Normalize every name once here instead of on every lookup
+	names := make([]string, len(players))
+	nameIndex := make(map[string]int, len(players))
+	for i, player := range players {
+		names[i] = normalizeName(player.Name)
+		if _, taken := nameIndex[names[i]]; !taken {
+			nameIndex[names[i]] = i // The first player with a name wins, matching the old scan order
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.players = players
+	s.names = names
+	s.nameIndex = nameIndex
+	s.targets = nil // Computed from the old pool
+}
+
+// PlayerByName looks up a player by exact normalized name in constant time
+func (s *Store) PlayerByName(normalized string) (Player, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	index, found := s.nameIndex[normalized]
+	if !found {
+		return Player{}, false
+	}
+	return s.players[index], true
+}
+
+// PlayersWithNames returns the pool together with the parallel slice of normalized names
+func (s *Store) PlayersWithNames() ([]Player, []string) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.players, s.names
+}
+
+// Targets returns the random-target candidates for the given selection settings, computing them
+// from the pool only when the settings or the pool changed since the last call, so repeated
+// selections (endurance, play again) cost O(1) instead of a pass over the whole pool
+func (s *Store) Targets(key string, compute func(pool []Player) []Player) []Player {
+	s.mu.RLock()
+	if s.targets != nil && s.targetKey == key {
+		defer s.mu.RUnlock()
+		return s.targets
+	}
+	s.mu.RUnlock()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.targets == nil || s.targetKey != key {
+		s.targets = compute(s.players)
+		if s.targets == nil {
+			s.targets = []Player{} // Cache an empty result too
+		}
+		s.targetKey = key
+	}
+	return s.targets
+}
+
+// CachedAPIPlayers returns the cached API result if it hasn't expired
+func (s *Store) CachedAPIPlayers() ([]Player, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if time.Now().Before(s.cacheExpiry) && len(s.apiCache) > 0 {
+		return s.apiCache, true
+	}
+	return nil, false
+}
+
+// CacheAPIPlayers stores an API result for the given duration
+func (s *Store) CacheAPIPlayers(players []Player, ttl time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.apiCache = players
+	s.cacheExpiry = time.Now().Add(ttl)
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"sync"    // Package for running readers and writers at once
+	"testing" // Package for Go tests
+	"time"    // Package for time-related operations
+)
+
+// TestApplyEnrichersCopies checks that enriching never rewrites the caller's slice, such as the API cache
+func TestApplyEnrichersCopies(t *testing.T) {
+	pool := []Player{{Name: "Test Player", Height: "6-9"}}
+	enriched := applyEnrichers(pool)
+	if pool[0].Height != "6-9" {
+		t.Errorf("the original pool was rewritten to height %q", pool[0].Height)
+	}
+	if enriched[0].Height == "6-9" {
+		t.Errorf("the enriched copy kept the raw height %q", enriched[0].Height)
+	}
+}
+
+// TestStoreConcurrentAccess runs readers against pool and cache refreshes; run it with -race
+func TestStoreConcurrentAccess(t *testing.T) {
+	previous := store.Players()
+	t.Cleanup(func() { store.SetPlayers(previous) })
+	players := getFallbackPlayers()
+	store.SetPlayers(players)
+	store.CacheAPIPlayers(players, time.Hour)
+	config := defaultConfig()
+
+	var wg sync.WaitGroup
+	for reader := 0; reader < 4; reader++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				for _, player := range store.Players() {
+					_ = player.Height
+				}
+				store.PlayerByName(normalizeName(players[i%len(players)].Name))
+				store.PlayersWithNames()
+				randomTargets(config)
+				if cached, found := store.CachedAPIPlayers(); found {
+					for _, player := range cached {
+						_ = player.Height
+					}
+				}
+			}
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 50; i++ {
+			// What initializePlayers does with a cache hit
+			cached, _ := store.CachedAPIPlayers()
+			store.SetPlayers(filterPool(applyEnrichers(cached), config))
+			store.CacheAPIPlayers(getFallbackPlayers(), time.Hour)
+		}
+	}()
+	wg.Wait()
+
+	if len(store.Players()) == 0 {
+		t.Error("the pool is empty after the refreshes")
+	}
+}
+
+// TestTargetsCache checks that targets are computed once per pool and key, and again after
+// the pool or the key changes
+func TestTargetsCache(t *testing.T) {
+	previous := store.Players()
+	t.Cleanup(func() { store.SetPlayers(previous) })
+	store.SetPlayers(getFallbackPlayers())
+
+	computed := 0
+	compute := func(pool []Player) []Player {
+		computed++
+		return pool[:1]
+	}
+	store.Targets("a", compute)
+	store.Targets("a", compute)
+	if computed != 1 {
+		t.Fatalf("the same key computed the targets %d times, want once", computed)
+	}
+	store.Targets("b", compute)
+	if computed != 2 {
+		t.Errorf("a new key reused the cached targets")
+	}
+	store.SetPlayers(getFallbackPlayers()[1:])
+	if got := store.Targets("b", compute); computed != 3 || got[0].Name != getFallbackPlayers()[1].Name {
+		t.Errorf("a new pool reused the cached targets (%d computations)", computed)
+	}
+
+	// An empty result is cached too, instead of being recomputed on every call
+	empty := func(pool []Player) []Player {
+		computed++
+		return nil
+	}
+	store.Targets("empty", empty)
+	store.Targets("empty", empty)
+	if computed != 4 {
+		t.Errorf("an empty target list was computed %d times, want once", computed-3)
+	}
+}
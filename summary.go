@@ -0,0 +1,72 @@
+package main
+
+import (
+	"encoding/json" // Package for JSON encoding and decoding
+	"fmt"           // Package for formatted I/O operations
+	"time"          // Package for time-related operations
+)
+
+// GameSummary is the one-line JSON result printed after each game for scripts and CI
+type GameSummary struct {
+	Outcome        string  `json:"outcome"`          // won, lost, time_up, or quit
+	Won            bool    `json:"won"`              // Whether the mystery player (or team) was guessed
+	Attempts       int     `json:"attempts"`         // Attempts used
+	Hints          int     `json:"hints"`            // Hints used
+	ElapsedSeconds float64 `json:"elapsed_seconds"`  // Time from the start of the game to the end
+	Target         string  `json:"target,omitempty"` // The answer; left out unless the game showed it
+	Mode           string  `json:"mode"`             // player, attributes, or team
+	QuizPoints     int     `json:"quiz_points"`      // Bonus points from the post-game -quiz
+}
+
+// String returns the outcome's name as used in the JSON summary
+func (o GameOutcome) String() string {
+	switch o {
+	case OutcomeWon:
+		return "won"
+	case OutcomeLost:
+		return "lost"
+	case OutcomeTimeUp:
+		return "time_up"
+	default:
+		return "quit"
+	}
+}
+
+// summaryEnabled reports whether the JSON summary is printed: always with -format=json, never with
+// -format=text, and by default only when input is scripted rather than typed at a terminal
+func summaryEnabled(config GameConfig, terminalInput bool) bool {
+	switch config.Format {
+	case "json":
+		return true
+	case "text":
+		return false
+	}
+	return !terminalInput
+}
+
+// newGameSummary captures a finished game's result from the engine's end-of-game state
+func newGameSummary(game *Game, outcome GameOutcome) GameSummary {
+	summary := GameSummary{
+		Outcome:        outcome.String(),
+		Won:            outcome == OutcomeWon,
+		Attempts:       game.Attempts,
+		Hints:          game.HintsUsed,
+		ElapsedSeconds: game.elapsed().Round(time.Millisecond).Seconds(),
+		Mode:           game.Config.Mode,
+		QuizPoints:     game.QuizPoints,
+	}
+	if game.AnswerShown {
+		_, summary.Target = mysteryAnswer(game) // Saved games and unrevealed no-spoil losses keep their secret
+	}
+	return summary
+}
+
+// printGameSummary prints the summary as a single JSON line, apart from the rest of the output
+func printGameSummary(game *Game, outcome GameOutcome) {
+	data, err := json.Marshal(newGameSummary(game, outcome))
+	if err != nil {
+		fmt.Printf("Warning: could not encode the game summary: %v\n", err)
+		return
+	}
+	fmt.Printf("\n%s\n", data)
+}
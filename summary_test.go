@@ -0,0 +1,74 @@
+package main
+
+import (
+	"encoding/json" // Package for JSON encoding and decoding
+	"path/filepath" // Package for building file paths
+	"testing"       // Package for Go tests
+)
+
+// TestGameSummaryJSON checks the JSON line for a scripted win, a loss, a saved quit, and
+// no-spoil losses, which name the answer only once it's revealed
+func TestGameSummaryJSON(t *testing.T) {
+	players := useFallbackPlayers(t)
+	tests := []struct {
+		name    string
+		mode    string
+		noSpoil bool
+		lines   func(game *Game) []string
+		want    string
+	}{
+		{"win", "player", false, func(game *Game) []string { return []string{testGuess(game).Name, "hint", game.Target.Name} },
+			`{"outcome":"won","won":true,"attempts":2,"hints":1,"elapsed_seconds":0,"target":"LeBron James","mode":"player","quiz_points":0}`},
+		{"loss", "player", false, func(game *Game) []string { return []string{players[1].Name, players[2].Name} },
+			`{"outcome":"lost","won":false,"attempts":2,"hints":0,"elapsed_seconds":0,"target":"LeBron James","mode":"player","quiz_points":0}`},
+		{"quit", "player", false, func(game *Game) []string { return []string{testGuess(game).Name, "quit"} },
+			`{"outcome":"quit","won":false,"attempts":1,"hints":0,"elapsed_seconds":0,"mode":"player","quiz_points":0}`},
+		{"no-spoil loss", "player", true, func(game *Game) []string { return []string{players[1].Name, players[2].Name, "quit"} },
+			`{"outcome":"lost","won":false,"attempts":2,"hints":0,"elapsed_seconds":0,"mode":"player","quiz_points":0}`},
+		{"no-spoil loss revealed", "player", true, func(game *Game) []string { return []string{players[1].Name, players[2].Name, "reveal"} },
+			`{"outcome":"lost","won":false,"attempts":2,"hints":0,"elapsed_seconds":0,"target":"LeBron James","mode":"player","quiz_points":0}`},
+		{"team no-spoil loss", "team", true, func(game *Game) []string { return []string{"Denver Nuggets", "Boston Celtics", "quit"} },
+			`{"outcome":"lost","won":false,"attempts":2,"hints":0,"elapsed_seconds":0,"mode":"team","quiz_points":0}`},
+		{"team no-spoil loss revealed", "team", true, func(game *Game) []string { return []string{"Denver Nuggets", "Boston Celtics", "reveal"} },
+			`{"outcome":"lost","won":false,"attempts":2,"hints":0,"elapsed_seconds":0,"target":"Los Angeles Lakers","mode":"team","quiz_points":0}`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			game, _ := newTestGame(t, func(config *GameConfig) {
+				config.Mode = test.mode
+				config.NoSpoil = test.noSpoil
+				config.MaxAttempts = 2
+				config.SaveFile = filepath.Join(t.TempDir(), "save.json")
+			})
+			outcome := playMode(game, inputLines(test.lines(game)...))
+			data, err := json.Marshal(newGameSummary(game, outcome))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != test.want {
+				t.Errorf("summary = %s\nwant      %s", data, test.want)
+			}
+		})
+	}
+}
+
+// TestSummaryEnabled checks when the JSON line is printed: by default only for scripted input
+func TestSummaryEnabled(t *testing.T) {
+	tests := []struct {
+		format        string
+		terminalInput bool
+		want          bool
+	}{
+		{"", false, true},
+		{"", true, false},
+		{"json", true, true},
+		{"text", false, false},
+	}
+	for _, test := range tests {
+		config := defaultConfig()
+		config.Format = test.format
+		if got := summaryEnabled(config, test.terminalInput); got != test.want {
+			t.Errorf("summaryEnabled(format %q, terminal input %v) = %v", test.format, test.terminalInput, got)
+		}
+	}
+}
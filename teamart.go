@@ -0,0 +1,54 @@
+package main
+
+import (
+	"strings" // Package for string manipulation functions
+)
+
+// teamArt holds small ASCII silhouettes of team logos that read well as text, keyed by abbreviation
+// Teams without an entry fall back to the plain-text team hint
+var teamArt = map[string][]string{
+	"BOS": {`  ()  `, ` ()() `, `  ||  `},       // Shamrock
+	"CHI": {`\\___//`, ` (o o) `, `  \v/  `},    // Bull's head
+	"MIA": {`   (   `, `  ) )  `, ` (_O_) `},    // Flaming ball
+	"MIL": {`\|/ \|/`, ` \_ _/ `, `  (_)  `},    // Antlers
+	"PHX": {` \ | / `, `-- O --`, ` / | \ `},    // Sun
+	"OKC": {`   __/`, `  /_  `, `   /  `},       // Lightning bolt
+	"HOU": {`  /\  `, ` |  | `, `/|__|\`},       // Rocket
+	"SAC": {`/\/\/\`, `|    |`, `|____|`},       // Crown
+	"UTA": {`  |\ `, `  | \`, `(_)  `},          // Music note
+	"GSW": {`|\    /|`, `|_\__/_|`, `~~~~~~~~`}, // Bridge
+	"BKN": {`|====|`, `\/\/\/`, ` \/\/ `},       // Net
+	"ORL": {`  /\  `, `<    >`, `  \/  `},       // Star
+	"NOP": {` __   `, `(o >  `, ` \_)__`},       // Pelican
+	"DEN": {` /\/\ `, `/ /\ \`, `~~~~~~`},       // Mountains
+	"TOR": {`/ / /`, `/ / /`, `' ' '`},          // Claw marks
+	"SAS": {`  |  `, `--*--`, `  |  `},          // Spur rowel
+	"ATL": {`  __ `, `<(o )`, `  \/ `},          // Hawk
+	"CHA": {`  __  `, `=(oo)=`, ` /||\ `},       // Hornet
+	"MEM": {` (\_/)`, ` (o o)`, ` (_,_)`},       // Bear
+	"MIN": {`/\  /\`, `( oo )`, ` \vv/ `},       // Wolf
+	"WAS": {`  /\ `, ` /__\`, `  *  `},          // Wizard's hat
+}
+
+// teamSilhouette returns a team's logo silhouette drawn in a box, or false when the team has
+// no art, so a team hint can show the logo as a softer clue than the name
+func teamSilhouette(teamName string) ([]string, bool) {
+	team, found := findTeam(teamName)
+	if !found {
+		return nil, false // Free agents and unknown teams
+	}
+	art, found := teamArt[team.Abbreviation]
+	if !found {
+		return nil, false
+	}
+
+	width := 0
+	for _, line := range art {
+		width = max(width, displayWidth(line))
+	}
+	box := []string{"+" + strings.Repeat("-", width+2) + "+"}
+	for _, line := range art {
+		box = append(box, "| "+line+strings.Repeat(" ", width-displayWidth(line))+" |")
+	}
+	return append(box, box[0]), true
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"strings" // Package for string manipulation functions
+	"testing" // Package for Go tests
+)
+
+// TestTeamArtFallback checks that -team-art shows a boxed logo for teams with art and falls back
+// to the team's name for teams without art, free agents, and unknown teams
+func TestTeamArtFallback(t *testing.T) {
+	config := defaultConfig()
+	config.TeamArt = true
+
+	target := getFallbackPlayers()[0]
+	target.Team = "Boston Celtics"
+	art, found := teamSilhouette(target.Team)
+	if !found {
+		t.Fatal("the Celtics have no art")
+	}
+	if hint := describeAttribute(target, "team", config); !strings.Contains(hint, "logo") || strings.Contains(hint, "Celtics") || !strings.Contains(hint, art[1]) {
+		t.Errorf("the Celtics hint should show the logo without the name:\n%s", hint)
+	}
+	for _, line := range art {
+		if displayWidth(line) != displayWidth(art[0]) {
+			t.Errorf("the Celtics art isn't boxed evenly:\n%s", strings.Join(art, "\n"))
+			break
+		}
+	}
+
+	for _, team := range []string{"Los Angeles Lakers", freeAgentTeam, "Springfield Atoms"} {
+		if _, found := teamSilhouette(team); found {
+			t.Errorf("%s has art", team)
+		}
+		target.Team = team
+		want := "The player's current team is: " + team
+		if hint := describeAttribute(target, "team", config); hint != want {
+			t.Errorf("%s hint = %q, want %q", team, hint, want)
+		}
+	}
+}
+
+// TestTeamArtKnownTeams checks that every piece of art belongs to a real team
+func TestTeamArtKnownTeams(t *testing.T) {
+	abbreviations := make(map[string]bool)
+	for _, team := range nbaTeams {
+		abbreviations[team.Abbreviation] = true
+	}
+	for abbreviation := range teamArt {
+		if !abbreviations[abbreviation] {
+			t.Errorf("art for unknown team %s", abbreviation)
+		}
+	}
+}
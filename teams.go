@@ -0,0 +1,210 @@
+package main
+
+import (
+	"fmt"       // Package for formatted I/O operations
+	"hash/fnv"  // Package for hashing a name to a stable team
+	"math/rand" // Package for generating random numbers
+	"strings"   // Package for string manipulation functions
+)
+
+// NBATeam describes one franchise for the mystery team mode
+type NBATeam struct {
+	Name         string // Full team name, matching Player.Team (e.g., "Boston Celtics")
+	City         string // Home city or region
+	Conference   string // "East" or "West"
+	Division     string // Division within the conference
+	Abbreviation string // Three-letter abbreviation
+}
+
+// nbaTeams lists every current NBA franchise
+var nbaTeams = []NBATeam{
+	{"Boston Celtics", "Boston", "East", "Atlantic", "BOS"},
+	{"Brooklyn Nets", "Brooklyn", "East", "Atlantic", "BKN"},
+	{"New York Knicks", "New York", "East", "Atlantic", "NYK"},
+	{"Philadelphia 76ers", "Philadelphia", "East", "Atlantic", "PHI"},
+	{"Toronto Raptors", "Toronto", "East", "Atlantic", "TOR"},
+	{"Chicago Bulls", "Chicago", "East", "Central", "CHI"},
+	{"Cleveland Cavaliers", "Cleveland", "East", "Central", "CLE"},
+	{"Detroit Pistons", "Detroit", "East", "Central", "DET"},
+	{"Indiana Pacers", "Indiana", "East", "Central", "IND"},
+	{"Milwaukee Bucks", "Milwaukee", "East", "Central", "MIL"},
+	{"Atlanta Hawks", "Atlanta", "East", "Southeast", "ATL"},
+	{"Charlotte Hornets", "Charlotte", "East", "Southeast", "CHA"},
+	{"Miami Heat", "Miami", "East", "Southeast", "MIA"},
+	{"Orlando Magic", "Orlando", "East", "Southeast", "ORL"},
+	{"Washington Wizards", "Washington", "East", "Southeast", "WAS"},
+	{"Denver Nuggets", "Denver", "West", "Northwest", "DEN"},
+	{"Minnesota Timberwolves", "Minnesota", "West", "Northwest", "MIN"},
+	{"Oklahoma City Thunder", "Oklahoma City", "West", "Northwest", "OKC"},
+	{"Portland Trail Blazers", "Portland", "West", "Northwest", "POR"},
+	{"Utah Jazz", "Utah", "West", "Northwest", "UTA"},
+	{"Golden State Warriors", "Golden State", "West", "Pacific", "GSW"},
+	{"LA Clippers", "Los Angeles", "West", "Pacific", "LAC"},
+	{"Los Angeles Lakers", "Los Angeles", "West", "Pacific", "LAL"},
+	{"Phoenix Suns", "Phoenix", "West", "Pacific", "PHX"},
+	{"Sacramento Kings", "Sacramento", "West", "Pacific", "SAC"},
+	{"Dallas Mavericks", "Dallas", "West", "Southwest", "DAL"},
+	{"Houston Rockets", "Houston", "West", "Southwest", "HOU"},
+	{"Memphis Grizzlies", "Memphis", "West", "Southwest", "MEM"},
+	{"New Orleans Pelicans", "New Orleans", "West", "Southwest", "NOP"},
+	{"San Antonio Spurs", "San Antonio", "West", "Southwest", "SAS"},
+}
+
+// findTeam looks up a team by full name, nickname ("Lakers"), abbreviation, or unique city
+func findTeam(input string) (NBATeam, bool) {
+	query := normalizeName(input)
+	if query == "" {
+		return NBATeam{}, false
+	}
+	if query == "los angeles clippers" {
+		query = "la clippers" // The API and fans use both spellings
+	}
+
+	var cityMatches []NBATeam
+	for _, team := range nbaTeams {
+		nickname := strings.TrimPrefix(normalizeName(team.Name), normalizeName(team.City)+" ")
+		if query == normalizeName(team.Name) || query == nickname || query == strings.ToLower(team.Abbreviation) {
+			return team, true
+		}
+		if query == normalizeName(team.City) {
+			cityMatches = append(cityMatches, team)
+		}
+	}
+	if len(cityMatches) == 1 {
+		return cityMatches[0], true // "Los Angeles" alone is ambiguous
+	}
+	return NBATeam{}, false
+}
+
+// teamOf returns the NBA team a player currently plays for, if any
+func teamOf(player Player) (NBATeam, bool) {
+	return findTeam(player.Team)
+}
+
+// teamComparison marks a guessed team's conference, division, and city against the mystery team
+func teamComparison(guess, target NBATeam, theme Theme) string {
+	status := func(match bool) MatchStatus {
+		if match {
+			return MatchExact
+		}
+		return MatchMiss
+	}
+	return strings.Join([]string{
+		theme.mark(status(guess.Name == target.Name), guess.Name),
+		"Conf " + theme.mark(status(guess.Conference == target.Conference), guess.Conference),
+		"Div " + theme.mark(status(guess.Division == target.Division), guess.Division),
+		"City " + theme.mark(status(guess.City == target.City), guess.City),
+	}, " | ")
+}
+
+// samplePlayer returns a random loaded player on the team, for the sample-player clue
+func samplePlayer(team NBATeam, rng *rand.Rand) (Player, bool) {
+	var roster []Player
+	for _, player := range store.Players() {
+		if found, ok := teamOf(player); ok && found.Name == team.Name {
+			roster = append(roster, player)
+		}
+	}
+	if len(roster) == 0 {
+		return Player{}, false
+	}
+	return roster[rng.Intn(len(roster))], true
+}
+
+// teamHint returns the clue for the given hint number: conference, then division, then a sample player
+func teamHint(team NBATeam, hintNumber int, rng *rand.Rand) string {
+	switch hintNumber {
+	case 1:
+		return fmt.Sprintf("The team plays in the %sern Conference", team.Conference)
+	case 2:
+		return fmt.Sprintf("The team plays in the %s Division", team.Division)
+	default:
+		if player, found := samplePlayer(team, rng); found {
+			return fmt.Sprintf("%s plays for the team", player.Name)
+		}
+		return fmt.Sprintf("The team's abbreviation starts with %c", team.Abbreviation[0])
+	}
+}
+
+// mysteryTeamFor picks the mystery team: the mystery player's team when they're on one, otherwise
+// a franchise chosen from the player's name, so the same target always gives the same team -
+// daily games, resumed games, and the end-of-game summary all agree
+func mysteryTeamFor(target Player) NBATeam {
+	if team, found := teamOf(target); found {
+		return team
+	}
+	hash := fnv.New32a()
+	hash.Write([]byte(target.Name))
+	return nbaTeams[hash.Sum32()%uint32(len(nbaTeams))]
+}
+
+// playTeamGame runs one game of team mode: guess the mystery NBA team from conference,
+// division, and city feedback
+func playTeamGame(game *Game, input <-chan string) GameOutcome {
+	team := mysteryTeamFor(game.Target)
+	theme := game.Config.theme()
+
+	for game.attemptsLeft() > 0 {
+		if game.timeExpired() {
+			fmt.Printf("\n⏰ TIME'S UP! You ran out of time after %s.\n", formatDuration(game.elapsed(), game.Config))
+			return endOnTimeUp(game, input)
+		}
+
+		if game.isTimed() {
+			fmt.Printf("\nAttempt %s - Time remaining: %s - Guess a team: ",
+				game.attemptLabel(), formatTimeRemaining(game.timeRemaining()))
+		} else {
+			fmt.Printf("\nAttempt %s - Guess a team: ", game.attemptLabel())
+		}
+
+		var guess string
+		select {
+		case line, ok := <-input:
+			if !ok {
+				line = "quit" // Treat the end of input like typing 'quit'
+			}
+			guess = strings.TrimSpace(line)
+		case <-game.paceTimeout():
+			fmt.Printf("\n🐢 Too slow! No guess within %s - %s.\n", game.Config.Pace, game.applyPacePenalty())
+			continue
+		case <-game.timeout():
+			fmt.Printf("\n⏰ TIME'S UP! You ran out of time.\n")
+			return endOnTimeUp(game, input)
+		}
+
+		switch strings.ToLower(guess) {
+		case "":
+			continue
+		case "quit":
+			return quitAndSave(game)
+		case "hint":
+			if game.hintsLeft() <= 0 {
+				fmt.Printf("❌ You've already used all %d hints!\n", game.Config.MaxHints)
+				continue
+			}
+			game.HintsUsed++
+			fmt.Printf("💡 Hint #%d: %s\n", game.HintsUsed, teamHint(team, game.HintsUsed, game.Config.rng()))
+			continue
+		}
+
+		guessed, found := findTeam(guess)
+		if !found {
+			fmt.Printf("❌ Team '%s' not found. Try a full name (Boston Celtics), nickname (Celtics), or abbreviation (BOS).\n", guess)
+			continue // Don't count this as an attempt
+		}
+
+		game.Attempts++
+		game.PaceStart = game.now() // A guess starts a new pace window
+		fmt.Println(teamComparison(guessed, team, theme))
+		if guessed.Name == team.Name {
+			fmt.Printf("\n🎉 CONGRATULATIONS! 🎉\n")
+			fmt.Printf("You found the %s in %d attempts and %s!\n", team.Name, game.Attempts, formatDuration(game.elapsed(), game.Config))
+			game.AnswerShown = true
+			return OutcomeWon
+		}
+	}
+
+	fmt.Printf("\n💔 Game Over! You've used all %d attempts.\n", game.Config.MaxAttempts)
+	revealOnLoss(game, input)
+	return OutcomeLost
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"os"      // Package for file operations
+	"strings" // Package for string manipulation functions
+	"testing" // Package for Go tests
+	"time"    // Package for time-related functions
+)
+
+// mustFindTeam looks up a team the test knows exists
+func mustFindTeam(t *testing.T, name string) NBATeam {
+	t.Helper()
+	team, found := findTeam(name)
+	if !found {
+		t.Fatalf("findTeam(%q) found nothing", name)
+	}
+	return team
+}
+
+// TestFindTeam checks every way a team can be named, and that an ambiguous city isn't enough
+func TestFindTeam(t *testing.T) {
+	tests := map[string]string{
+		"Boston Celtics":       "Boston Celtics",
+		"celtics":              "Boston Celtics",
+		"BOS":                  "Boston Celtics",
+		"Boston":               "Boston Celtics",
+		"Los Angeles Clippers": "LA Clippers",
+		"Los Angeles":          "", // Lakers or Clippers
+		"Springfield":          "",
+	}
+	for input, want := range tests {
+		got := ""
+		if team, found := findTeam(input); found {
+			got = team.Name
+		}
+		if got != want {
+			t.Errorf("findTeam(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+// TestTeamComparison checks each field's marker against the mystery team
+func TestTeamComparison(t *testing.T) {
+	theme := themes["default"]
+	celtics := mustFindTeam(t, "Boston Celtics")
+	tests := []struct {
+		guess string
+		want  []string // Markers for team, conference, division, and city
+	}{
+		{"Boston Celtics", []string{theme.Exact, theme.Exact, theme.Exact, theme.Exact}},
+		{"New York Knicks", []string{theme.Miss, theme.Exact, theme.Exact, theme.Miss}},
+		{"Miami Heat", []string{theme.Miss, theme.Exact, theme.Miss, theme.Miss}},
+		{"Denver Nuggets", []string{theme.Miss, theme.Miss, theme.Miss, theme.Miss}},
+	}
+	for _, test := range tests {
+		fields := strings.Split(teamComparison(mustFindTeam(t, test.guess), celtics, theme), " | ")
+		if len(fields) != len(test.want) {
+			t.Fatalf("%s: comparison has %d fields: %v", test.guess, len(fields), fields)
+		}
+		for i, marker := range test.want {
+			if !strings.Contains(fields[i], marker) {
+				t.Errorf("%s: field %q, want marker %s", test.guess, fields[i], marker)
+			}
+		}
+	}
+}
+
+// TestMysteryTeamFor checks that a player's own team is the mystery team, and that a free
+// agent always maps to the same franchise
+func TestMysteryTeamFor(t *testing.T) {
+	player := getFallbackPlayers()[0]
+	if got := mysteryTeamFor(player); got.Name != player.Team {
+		t.Errorf("mysteryTeamFor(%s) = %s, want their team %s", player.Name, got.Name, player.Team)
+	}
+	player.Team = freeAgentTeam
+	first := mysteryTeamFor(player)
+	if again := mysteryTeamFor(player); again != first {
+		t.Errorf("a free agent's mystery team changed from %s to %s", first.Name, again.Name)
+	}
+}
+
+// TestPlayTeamGame checks a scripted team game: unknown teams are free and the mystery team wins
+func TestPlayTeamGame(t *testing.T) {
+	useFallbackPlayers(t)
+	game, _ := newTestGame(t, func(c *GameConfig) {
+		c.Mode = "team"
+		c.SaveFile = t.TempDir() + "/save.json"
+	})
+	team := mysteryTeamFor(game.Target)
+	other := "Denver Nuggets"
+	if team.Name == other {
+		other = "Boston Celtics"
+	}
+	outcome := playTeamGame(game, inputLines("Springfield Atoms", other, team.Abbreviation))
+	if outcome != OutcomeWon || game.Attempts != 2 {
+		t.Errorf("an unknown team, a wrong team, then the answer: %v in %d attempts, want a win in 2", outcome, game.Attempts)
+	}
+}
+
+// newTeamGame starts a team-mode game on a fake clock with one attempt, returning it with a wrong team to guess
+func newTeamGame(t *testing.T, change func(*GameConfig)) (*Game, string) {
+	t.Helper()
+	useFallbackPlayers(t)
+	game, _ := newTestGame(t, func(c *GameConfig) {
+		c.Mode = "team"
+		c.MaxAttempts = 1
+		c.SaveFile = t.TempDir() + "/save.json"
+		if change != nil {
+			change(c)
+		}
+	})
+	wrong := "Denver Nuggets"
+	if mysteryTeamFor(game.Target).Name == wrong {
+		wrong = "Boston Celtics"
+	}
+	return game, wrong
+}
+
+// TestTeamGameLossReveal checks that a lost team game reveals the team only when no-spoil
+// allows it, and records whether it did
+func TestTeamGameLossReveal(t *testing.T) {
+	tests := []struct {
+		name    string
+		noSpoil bool
+		after   []string // Input at the no-spoil prompt
+		shown   bool
+	}{
+		{"spoilers", false, nil, true},
+		{"no-spoil reveal", true, []string{"reveal"}, true},
+		{"no-spoil quit", true, []string{"quit"}, false},
+		{"no-spoil input ends", true, nil, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			game, wrong := newTeamGame(t, func(c *GameConfig) { c.NoSpoil = test.noSpoil })
+			outcome := playTeamGame(game, inputLines(append([]string{wrong}, test.after...)...))
+			if outcome != OutcomeLost || game.AnswerShown != test.shown {
+				t.Errorf("%v with the answer shown %v, want a loss with it shown %v", outcome, game.AnswerShown, test.shown)
+			}
+		})
+	}
+}
+
+// TestTeamGameQuitSaves checks that quitting a team game saves it, like the other modes
+func TestTeamGameQuitSaves(t *testing.T) {
+	game, _ := newTeamGame(t, nil)
+	if outcome := playTeamGame(game, inputLines("quit")); outcome != OutcomeQuit || game.AnswerShown {
+		t.Errorf("quit: %v with the answer shown %v, want a quit that keeps it hidden", outcome, game.AnswerShown)
+	}
+	if _, err := os.Stat(game.Config.SaveFile); err != nil {
+		t.Errorf("quitting didn't save the game: %v", err)
+	}
+}
+
+// TestTeamGamePace checks that -pace applies in team mode: a missed window costs an attempt once
+// there are no hints to lose
+func TestTeamGamePace(t *testing.T) {
+	game, _ := newTeamGame(t, func(c *GameConfig) {
+		c.MaxHints = 0
+		c.Pace = 10 * time.Second
+	})
+	game.PaceStart = game.now().Add(-game.Config.Pace) // The window has already run out
+	if outcome := playTeamGame(game, make(chan string)); outcome != OutcomeLost || game.Attempts != 1 {
+		t.Errorf("a missed pace window with one attempt: %v in %d attempts, want a loss in 1", outcome, game.Attempts)
+	}
+}
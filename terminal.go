@@ -0,0 +1,120 @@
+package main
+
+import (
+	"fmt"     // Package for formatted I/O operations
+	"os"      // Package for file operations
+	"regexp"  // Package for matching terminal escape sequences
+	"strings" // Package for string manipulation functions
+	"sync"    // Package for synchronization primitives
+	"time"    // Package for time-related operations
+	"unicode" // Package for classifying control characters
+)
+
+// isTerminal reports whether the given file is an interactive terminal rather than a pipe or file
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// escapeSequencePattern matches ANSI escape sequences: CSI sequences like "\x1b[31m" and
+// OSC sequences like window-title changes, ended by BEL or ESC \
+var escapeSequencePattern = regexp.MustCompile(`\x1b\[[0-?]*[ -/]*[@-~]|\x1b\][^\x07\x1b]*(\x07|\x1b\\)?|\x1b.?`)
+
+// sanitizeInput removes escape sequences, control characters, and invisible formatting
+// characters (such as right-to-left overrides) from a line of input, so echoing it back
+// can't restyle or garble the terminal; tabs become spaces
+func sanitizeInput(line string) string {
+	line = escapeSequencePattern.ReplaceAllString(line, "")
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r == '\t':
+			return ' '
+		case unicode.IsControl(r) || unicode.Is(unicode.Cf, r):
+			return -1 // Dropped
+		}
+		return r
+	}, line)
+}
+
+// spinnerFrames are drawn in order to animate the progress line
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// fetchProgress reports player-loading progress, either as a single updating
+// line on a terminal or as one log line per page everywhere else
+type fetchProgress struct {
+	live      bool          // Draw a spinner line instead of printing log lines
+	startTime time.Time     // When loading started, for the elapsed display
+	mu        sync.Mutex    // Guards count while the spinner goroutine reads it
+	count     int           // Players loaded so far
+	done      chan struct{} // Closed to stop the spinner goroutine
+	stopped   chan struct{} // Closed once the spinner line has been cleared
+	stopOnce  sync.Once     // Makes stop safe to call more than once
+}
+
+// newFetchProgress starts a progress reporter; the spinner is used only on a terminal and when not verbose
+func newFetchProgress(verbose bool) *fetchProgress {
+	p := &fetchProgress{
+		live:      !verbose && isTerminal(os.Stdout),
+		startTime: time.Now(),
+		done:      make(chan struct{}),
+		stopped:   make(chan struct{}),
+	}
+	if p.live {
+		go p.spin()
+	} else {
+		close(p.stopped) // Nothing to wait for without a spinner
+	}
+	return p
+}
+
+// spin redraws the progress line at most ten times per second until stopped
+func (p *fetchProgress) spin() {
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	defer close(p.stopped)
+
+	for frame := 0; ; frame++ {
+		select {
+		case <-p.done:
+			fmt.Print("\r\033[K") // Clear the line so later output starts clean
+			return
+		case <-ticker.C:
+			p.mu.Lock()
+			count := p.count
+			p.mu.Unlock()
+			fmt.Printf("\r\033[K%s Loading players... %d loaded (%s)",
+				spinnerFrames[frame%len(spinnerFrames)], count, formatTimeRemaining(time.Since(p.startTime)))
+		}
+	}
+}
+
+// update records the latest totals after a page has been processed
+func (p *fetchProgress) update(total, processed, cursor int) {
+	if !p.live {
+		fmt.Printf("Loaded %d players so far... (processed %d from cursor %d)\n", total, processed, cursor)
+		return
+	}
+	p.mu.Lock()
+	p.count = total
+	p.mu.Unlock()
+}
+
+// logf prints a detail line only when the spinner is not drawing over the same line
+func (p *fetchProgress) logf(format string, args ...interface{}) {
+	if !p.live {
+		fmt.Printf(format, args...)
+	}
+}
+
+// stop clears the spinner line and waits for it to disappear before returning
+func (p *fetchProgress) stop() {
+	p.stopOnce.Do(func() {
+		if p.live {
+			close(p.done)
+		}
+	})
+	<-p.stopped
+}
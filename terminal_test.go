@@ -0,0 +1,45 @@
+package main
+
+import (
+	"bufio"   // Package for scanning test input
+	"strings" // Package for string manipulation functions
+	"testing" // Package for Go tests
+)
+
+// TestSanitizeInput checks that escape sequences, control characters, and invisible
+// formatting are stripped while names, accents, and spacing survive
+func TestSanitizeInput(t *testing.T) {
+	tests := map[string]string{
+		"LeBron James":                            "LeBron James",
+		"Nikola Jokić":                            "Nikola Jokić",
+		"\x1b[31mLeBron\x1b[0m James":             "LeBron James",
+		"\x1b[2J\x1b[HStephen Curry":              "Stephen Curry",
+		"\x1b]0;pwned\x07Kevin Durant":            "Kevin Durant",
+		"\x1b]0;pwned\x1b\\Kevin Durant":          "Kevin Durant",
+		"Luka\x00 Don\x08čić\r":                   "Luka Dončić",
+		"Joel\tEmbiid":                            "Joel Embiid",
+		"Jayson\u202e Tatum\u200b":                "Jayson Tatum",
+		"\x1bcAnthony Davis":                      "Anthony Davis",
+		"hint\x1b[":                               "hint",
+		"\x07\x7f\u0085":                          "",
+		"Giannis\x1b[38;2;255;0;0m Antetokounmpo": "Giannis Antetokounmpo",
+	}
+	for input, want := range tests {
+		if got := sanitizeInput(input); got != want {
+			t.Errorf("sanitizeInput(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+// TestInputReaderSanitizes checks that every line read from the player is cleaned before any
+// prompt sees it
+func TestInputReaderSanitizes(t *testing.T) {
+	input := startInputReader(bufio.NewScanner(strings.NewReader("\x1b[1mLeBron James\x1b[0m\nhi\x00nt\n")))
+	var lines []string
+	for line := range input {
+		lines = append(lines, line)
+	}
+	if len(lines) != 2 || lines[0] != "LeBron James" || lines[1] != "hint" {
+		t.Errorf("read %q, want the sanitized lines", lines)
+	}
+}
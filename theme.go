@@ -0,0 +1,80 @@
+package main
+
+import (
+	"sort"    // Package for sorting slices
+	"strings" // Package for string manipulation functions
+)
+
+// MatchStatus describes how closely one attribute of a guess matches the mystery player
+type MatchStatus int
+
+const (
+	MatchMiss    MatchStatus = iota // Attribute doesn't match
+	MatchClose                      // Attribute is within the closeness tolerance
+	MatchExact                      // Attribute matches exactly
+	MatchUnknown                    // Attribute can't be compared because data is missing
+)
+
+// String returns a plain-text name for the status, used in exports
+func (s MatchStatus) String() string {
+	switch s {
+	case MatchExact:
+		return "exact"
+	case MatchClose:
+		return "close"
+	case MatchUnknown:
+		return "unknown"
+	default:
+		return "miss"
+	}
+}
+
+// Theme defines the marker drawn in front of each compared value
+type Theme struct {
+	Exact   string // Marker for exact matches
+	Close   string // Marker for close matches
+	Miss    string // Marker for mismatches
+	Unknown string // Marker for values that can't be compared
+}
+
+// themes lists every theme selectable with -theme
+var themes = map[string]Theme{
+	"default":    {Exact: "🟢", Close: "🟡", Miss: "🔴", Unknown: "⚪"}, // Classic colored circles
+	"basketball": {Exact: "🏀", Close: "🟨", Miss: "⬛", Unknown: "⬜"}, // Hoops-flavored markers
+	"squares":    {Exact: "🟩", Close: "🟨", Miss: "🟥", Unknown: "⬜"}, // Word-game style squares
+}
+
+// themeNames returns the available theme names in sorted order
+func themeNames() []string {
+	names := make([]string, 0, len(themes))
+	for name := range themes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// getTheme looks up a theme by case-insensitive name
+func getTheme(name string) (Theme, bool) {
+	theme, found := themes[strings.ToLower(strings.TrimSpace(name))]
+	return theme, found
+}
+
+// marker returns the theme's marker for a match status
+func (t Theme) marker(status MatchStatus) string {
+	switch status {
+	case MatchExact:
+		return t.Exact
+	case MatchClose:
+		return t.Close
+	case MatchUnknown:
+		return t.Unknown
+	default:
+		return t.Miss
+	}
+}
+
+// mark prefixes a value with the marker for its match status
+func (t Theme) mark(status MatchStatus, value string) string {
+	return t.marker(status) + " " + value
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"strings" // Package for string manipulation functions
+	"testing" // Package for Go tests
+)
+
+// TestThemesRenderComparisons checks that every theme's markers, and only its markers, prefix
+// each compared cell
+func TestThemesRenderComparisons(t *testing.T) {
+	guess, target := comparePlayers(func(p *Player) {
+		p.Team = "Other Team" // Miss
+		p.DraftYear++         // Close
+		p.Weight = 0          // Unknown
+	}, nil)
+	for _, name := range themeNames() {
+		config := defaultConfig()
+		config.Theme = strings.ToUpper(name) // Theme names are case-insensitive
+		theme := themes[name]
+		result := compareWithTarget(guess, target, config)
+		cells := map[string]string{"team": result.Team, "draftyear": result.DraftYear, "weight": result.Weight, "position": result.Position}
+		want := map[string]string{"team": theme.Miss, "draftyear": theme.Close, "weight": theme.Unknown, "position": theme.Exact}
+		for attribute, cell := range cells {
+			if !strings.HasPrefix(cell, want[attribute]+" ") {
+				t.Errorf("%s theme: %s cell %q doesn't start with %q", name, attribute, cell, want[attribute])
+			}
+		}
+		row := result.tableString(config.tableStyle())
+		for other, otherTheme := range themes {
+			if otherTheme.Miss != theme.Miss && strings.Contains(row, otherTheme.Miss) {
+				t.Errorf("%s theme row has the %s theme's miss marker: %s", name, other, row)
+			}
+		}
+	}
+}
+
+// TestUnknownThemeFallsBack checks that an unknown theme name draws the default markers
+func TestUnknownThemeFallsBack(t *testing.T) {
+	config := defaultConfig()
+	config.Theme = "neon"
+	if config.theme() != themes["default"] {
+		t.Errorf("theme %q gave %+v, want the default theme", config.Theme, config.theme())
+	}
+}
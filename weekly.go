@@ -0,0 +1,139 @@
+package main
+
+import (
+	"fmt"       // Package for formatted I/O operations
+	"math/rand" // Package for generating random numbers
+	"strings"   // Package for string manipulation functions
+	"time"      // Package for time-related operations
+)
+
+// daysPerWeek is how many puzzles a weekly challenge has, one unlocked each day
+const daysPerWeek = 7
+
+// WeeklyResult records the finished puzzles of one weekly challenge
+type WeeklyResult struct {
+	Days map[int]DailyResult `json:"days"` // Results keyed by puzzle day (1 = Monday ... 7 = Sunday)
+}
+
+// weekKey returns the UTC ISO week a weekly challenge belongs to, e.g. "2024-W11"
+func weekKey(now time.Time) string {
+	year, week := now.UTC().ISOWeek()
+	return fmt.Sprintf("%d-W%02d", year, week)
+}
+
+// weekDay returns the puzzle day unlocked on the given date: 1 on Monday through 7 on Sunday (UTC)
+func weekDay(now time.Time) int {
+	return (int(now.UTC().Weekday())+6)%daysPerWeek + 1
+}
+
+// weeklySeed turns a week key and puzzle day into a random seed, e.g. "2024-W11", 3 -> 2024113
+// The seeds have seven digits, so they never collide with the eight-digit daily seeds
+func weeklySeed(key string, day int) int64 {
+	var year, week int
+	fmt.Sscanf(key, "%d-W%d", &year, &week)
+	return int64(year*1000 + week*10 + day)
+}
+
+// getWeeklyPlayer returns the mystery player for one day of a weekly challenge; everyone gets the same seven
+func getWeeklyPlayer(key string, day int, config GameConfig) (Player, error) {
+	players := eligibleTargets(store.Players(), config)
+	if len(players) == 0 {
+		return Player{}, noTargetsError(store.Players(), config)
+	}
+	rng := rand.New(rand.NewSource(weeklySeed(key, day)))
+	return players[rng.Intn(len(players))], nil
+}
+
+// nextWeeklyDay returns the earliest unlocked puzzle that hasn't been finished, so missed days
+// can be caught up later in the week, or false when every unlocked puzzle is done
+func nextWeeklyDay(result *WeeklyResult, today int) (int, bool) {
+	for day := 1; day <= today; day++ {
+		if _, played := result.Days[day]; !played {
+			return day, true
+		}
+	}
+	return 0, false
+}
+
+// weeklyPoints scores a finished puzzle: 1 point for a win plus 1 per attempt left over
+func weeklyPoints(game *Game, outcome GameOutcome) int {
+	if outcome != OutcomeWon {
+		return 0
+	}
+	if game.Config.UnlimitedAttempts {
+		return 1 // No attempt limit, so nothing was left over
+	}
+	return 1 + game.attemptsLeft()
+}
+
+// score returns the cumulative score of every finished puzzle in the week
+func (w *WeeklyResult) score() int {
+	total := 0
+	for _, result := range w.Days {
+		total += result.Score
+	}
+	return total
+}
+
+// weeklyGrid draws the week's completion grid: ✅ won, ❌ lost, ⬜ unlocked, 🔒 not unlocked yet
+func weeklyGrid(result *WeeklyResult, today int) string {
+	cells := make([]string, daysPerWeek)
+	for day := 1; day <= daysPerWeek; day++ {
+		played, done := result.Days[day]
+		switch {
+		case done && played.Won:
+			cells[day-1] = "✅"
+		case done:
+			cells[day-1] = "❌"
+		case day <= today:
+			cells[day-1] = "⬜"
+		default:
+			cells[day-1] = "🔒"
+		}
+	}
+	return strings.Join(cells, " ")
+}
+
+// printWeeklySummary shows the week's grid, how many puzzles are done, and the cumulative score
+func printWeeklySummary(key string, result *WeeklyResult, today int) {
+	fmt.Printf("🗓️  Weekly %s: %s  (%d/%d done, score %d)\n", key, weeklyGrid(result, today), len(result.Days), daysPerWeek, result.score())
+}
+
+// weeklyResult returns the record for a week from the stats, creating it on first use
+func (s *Stats) weeklyResult(key string) *WeeklyResult {
+	result, found := s.Weekly[key]
+	if !found || result == nil {
+		result = &WeeklyResult{}
+		s.Weekly[key] = result
+	}
+	if result.Days == nil {
+		result.Days = make(map[int]DailyResult)
+	}
+	return result
+}
+
+// recordWeeklyResult stores a finished weekly puzzle and shows the updated grid
+func recordWeeklyResult(game *Game, outcome GameOutcome) error {
+	stats, err := loadStats(game.Config.StatsFile)
+	if err != nil {
+		return err
+	}
+	result := stats.weeklyResult(game.WeeklyKey)
+	result.Days[game.WeeklyDay] = DailyResult{
+		Won:      outcome == OutcomeWon,
+		Attempts: game.Attempts,
+		Zen:      game.Config.NoTimeLimit && game.Config.UnlimitedAttempts,
+		Score:    weeklyPoints(game, outcome) + game.QuizPoints,
+	}
+	if err := stats.save(game.Config.StatsFile); err != nil {
+		return err
+	}
+
+	// A puzzle from a week that has since ended is shown with every day unlocked
+	today := daysPerWeek
+	if game.WeeklyKey == weekKey(time.Now()) {
+		today = weekDay(time.Now())
+	}
+	printWeeklySummary(game.WeeklyKey, result, today)
+	return nil
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"path/filepath" // Package for building file paths
+	"testing"       // Package for Go tests
+	"time"          // Package for time-related operations
+)
+
+// TestWeekKey checks the ISO week and puzzle day of dates around week and year boundaries
+func TestWeekKey(t *testing.T) {
+	tests := []struct {
+		date time.Time
+		key  string
+		day  int
+	}{
+		{time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC), "2024-W11", 1},
+		{time.Date(2024, 3, 17, 23, 59, 0, 0, time.UTC), "2024-W11", 7},
+		{time.Date(2024, 3, 18, 0, 0, 0, 0, time.UTC), "2024-W12", 1},
+		{time.Date(2024, 12, 30, 12, 0, 0, 0, time.UTC), "2025-W01", 1}, // ISO weeks can start the next year early
+		{time.Date(2021, 1, 3, 12, 0, 0, 0, time.UTC), "2020-W53", 7},   // ... or end the previous one late
+		{time.Date(2024, 3, 17, 20, 0, 0, 0, time.FixedZone("UTC-5", -5*3600)), "2024-W12", 1},
+	}
+	for _, test := range tests {
+		if got := weekKey(test.date); got != test.key {
+			t.Errorf("weekKey(%v) = %q, want %q", test.date, got, test.key)
+		}
+		if got := weekDay(test.date); got != test.day {
+			t.Errorf("weekDay(%v) = %d, want %d", test.date, got, test.day)
+		}
+	}
+}
+
+// TestWeeklyAcrossSevenDays checks that the seven puzzles of a week are recorded together, add
+// up to the week's score, and leave nothing to play once all are done
+func TestWeeklyAcrossSevenDays(t *testing.T) {
+	useFallbackPlayers(t)
+	statsFile := filepath.Join(t.TempDir(), "stats.json")
+	const key = "2024-W11"
+
+	seen := make(map[string]bool)
+	wantScore := 0
+	for day := 1; day <= daysPerWeek; day++ {
+		game, _ := newTestGame(t, func(config *GameConfig) { config.StatsFile = statsFile })
+		target, err := getWeeklyPlayer(key, day, game.Config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		seen[target.Name] = true
+		game.Target = target
+		game.WeeklyKey, game.WeeklyDay = key, day
+
+		outcome := OutcomeWon
+		if day%3 == 0 {
+			outcome = OutcomeLost // Losses are recorded but score nothing
+		}
+		game.Attempts = day
+		wantScore += weeklyPoints(game, outcome)
+		if err := recordWeeklyResult(game, outcome); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(seen) < 2 {
+		t.Errorf("every day of %s had the same mystery player", key)
+	}
+
+	stats, err := loadStats(statsFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result := stats.Weekly[key]
+	if result == nil || len(result.Days) != daysPerWeek {
+		t.Fatalf("recorded week %+v, want %d days", result, daysPerWeek)
+	}
+	if got := result.score(); got != wantScore {
+		t.Errorf("week score = %d, want %d", got, wantScore)
+	}
+	if got := weeklyGrid(result, daysPerWeek); got != "✅ ✅ ❌ ✅ ✅ ❌ ✅" {
+		t.Errorf("week grid = %q", got)
+	}
+	if day, available := nextWeeklyDay(result, daysPerWeek); available {
+		t.Errorf("nextWeeklyDay after the whole week = %d, want none", day)
+	}
+}
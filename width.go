@@ -0,0 +1,80 @@
+package main
+
+// wideRanges lists the code points terminals draw two columns wide: East Asian wide characters
+// and emoji, including the markers every table cell starts with (🟢, ⚪, ⬛, ...)
+var wideRanges = [][2]rune{
+	{0x1100, 0x115F},   // Hangul Jamo initial consonants
+	{0x231A, 0x231B},   // ⌚⌛
+	{0x23E9, 0x23EC},   // ⏩⏪⏫⏬
+	{0x23F0, 0x23F0},   // ⏰
+	{0x23F3, 0x23F3},   // ⏳
+	{0x25FD, 0x25FE},   // ◽◾
+	{0x2614, 0x2615},   // ☔☕
+	{0x2648, 0x2653},   // Zodiac signs
+	{0x267F, 0x267F},   // ♿
+	{0x2693, 0x2693},   // ⚓
+	{0x26A1, 0x26A1},   // ⚡
+	{0x26AA, 0x26AB},   // ⚪⚫
+	{0x26BD, 0x26BE},   // ⚽⚾
+	{0x26C4, 0x26C5},   // ⛄⛅
+	{0x26CE, 0x26CE},   // ⛎
+	{0x26D4, 0x26D4},   // ⛔
+	{0x26EA, 0x26EA},   // ⛪
+	{0x26F2, 0x26F3},   // ⛲⛳
+	{0x26F5, 0x26F5},   // ⛵
+	{0x26FA, 0x26FA},   // ⛺
+	{0x26FD, 0x26FD},   // ⛽
+	{0x2705, 0x2705},   // ✅
+	{0x270A, 0x270B},   // ✊✋
+	{0x2728, 0x2728},   // ✨
+	{0x274C, 0x274C},   // ❌
+	{0x274E, 0x274E},   // ❎
+	{0x2753, 0x2755},   // ❓❔❕
+	{0x2757, 0x2757},   // ❗
+	{0x2795, 0x2797},   // ➕➖➗
+	{0x27B0, 0x27B0},   // ➰
+	{0x27BF, 0x27BF},   // ➿
+	{0x2B1B, 0x2B1C},   // ⬛⬜
+	{0x2B50, 0x2B50},   // ⭐
+	{0x2B55, 0x2B55},   // ⭕
+	{0x2E80, 0x303E},   // CJK radicals and punctuation
+	{0x3041, 0x33FF},   // Kana and CJK compatibility
+	{0x3400, 0x4DBF},   // CJK extension A
+	{0x4E00, 0x9FFF},   // CJK unified ideographs
+	{0xA000, 0xA4CF},   // Yi
+	{0xAC00, 0xD7A3},   // Hangul syllables
+	{0xF900, 0xFAFF},   // CJK compatibility ideographs
+	{0xFE30, 0xFE4F},   // CJK compatibility forms
+	{0xFF00, 0xFF60},   // Fullwidth forms
+	{0xFFE0, 0xFFE6},   // Fullwidth signs
+	{0x1F300, 0x1F64F}, // Pictographs and emoticons (🏀, 🔴, ...)
+	{0x1F680, 0x1F6FF}, // Transport and map symbols
+	{0x1F7E0, 0x1F7EB}, // Colored circles and squares (🟢🟡🟨🟩🟥)
+	{0x1F900, 0x1FAFF}, // Supplemental pictographs
+	{0x20000, 0x3FFFD}, // CJK extensions B and beyond
+}
+
+// runeWidth returns how many terminal columns a rune takes: 0 for joiners, variation selectors,
+// and combining accents, 2 for wide characters and emoji, and 1 for everything else
+func runeWidth(r rune) int {
+	switch {
+	case r == 0x200D, r >= 0xFE00 && r <= 0xFE0F, r >= 0x0300 && r <= 0x036F, r >= 0x1F3FB && r <= 0x1F3FF:
+		return 0 // Zero-width joiner, variation selectors, combining marks, skin tone modifiers
+	}
+	for _, wide := range wideRanges {
+		if r >= wide[0] && r <= wide[1] {
+			return 2
+		}
+	}
+	return 1
+}
+
+// displayWidth returns how many terminal columns a string takes, unlike len (bytes) or
+// counting runes, both of which misjudge emoji
+func displayWidth(s string) int {
+	width := 0
+	for _, r := range s {
+		width += runeWidth(r)
+	}
+	return width
+}
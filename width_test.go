@@ -0,0 +1,53 @@
+package main
+
+import (
+	"fmt"          // Package for formatted I/O operations
+	"testing"      // Package for Go tests
+	"unicode/utf8" // Package for counting runes
+)
+
+// TestDisplayWidth checks terminal columns against byte and rune counts, which both misjudge emoji
+func TestDisplayWidth(t *testing.T) {
+	tests := []struct {
+		value              string
+		bytes, runes, cols int
+	}{
+		{"LAL", 3, 3, 3},
+		{"Jokić", 6, 5, 5},
+		{"🟢 LAL", 8, 5, 6},
+		{"⚪ USA", 7, 5, 6},
+		{"⬛ 23", 6, 4, 5},
+		{"🏀", 4, 1, 2},
+		{"\u2764\ufe0f", 6, 2, 1},         // A narrow heart with a zero-width variation selector
+		{"\U0001F44D\U0001F3FD", 8, 2, 2}, // A skin tone modifier adds no width
+		{"e\u0301", 3, 2, 1},              // A combining accent adds no width
+		{"八村塁", 9, 3, 6},
+	}
+	for _, test := range tests {
+		if len(test.value) != test.bytes || utf8.RuneCountInString(test.value) != test.runes {
+			t.Fatalf("%q: %d bytes and %d runes, the table says %d and %d", test.value, len(test.value), utf8.RuneCountInString(test.value), test.bytes, test.runes)
+		}
+		if got := displayWidth(test.value); got != test.cols {
+			t.Errorf("displayWidth(%q) = %d, want %d", test.value, got, test.cols)
+		}
+	}
+}
+
+// TestEmojiCellPadding checks that cells padded by display width line up whatever their marker,
+// where padding by bytes or runes leaves each marker's cells a different width
+func TestEmojiCellPadding(t *testing.T) {
+	cells := []string{"🟢 LAL", "⚪ LAL", "⬛ LAL", "🟨 LAL", "= LAL"}
+	const width = 10
+	byteWidths := make(map[int]bool)
+	runeWidths := make(map[int]bool)
+	for _, cell := range cells {
+		byteWidths[displayWidth(fmt.Sprintf("%-*s", width+len(cell)-utf8.RuneCountInString(cell), cell))] = true
+		runeWidths[displayWidth(fmt.Sprintf("%-*s", width, cell))] = true
+		if got := displayWidth(fitColumn(cell, width)); got != width {
+			t.Errorf("fitColumn(%q, %d) is %d columns wide", cell, width, got)
+		}
+	}
+	if len(byteWidths) < 2 || len(runeWidths) < 2 {
+		t.Errorf("padding by bytes or runes happened to line up (%v, %v); the cells don't exercise the fix", byteWidths, runeWidths)
+	}
+}